@@ -0,0 +1,93 @@
+// Package apierror renders the errors of the REST routes in the same JSON envelope, whether they come from the backends
+// or from the gateway itself, e.g. the authentication and the rate limits.
+//
+// The envelope extends the google.rpc.Status the gateway used to render, so the clients reading the code, the message
+// and the details keep working:
+//
+//	{
+//	  "code": 5,
+//	  "status": "NOT_FOUND",
+//	  "message": "race 1234 not found",
+//	  "details": [
+//	    {"@type": "type.googleapis.com/google.rpc.ResourceInfo", "resourceType": "racing.Race", "resourceName": "1234", ...},
+//	    {"@type": "type.googleapis.com/google.rpc.RequestInfo", "requestId": "4f1c...", "servingData": ""}
+//	  ],
+//	  "requestId": "4f1c..."
+//	}
+package apierror
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"git.neds.sh/matty/entain/api/logging"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/status"
+)
+
+// Envelope is the body of the error responses.
+type Envelope struct {
+	// Code is the gRPC status code, e.g. 5.
+	Code int32 `json:"code"`
+	// Status is the name of the code, e.g. NOT_FOUND.
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	// Details are the google.rpc error details, e.g. the BadRequest field violations, with their @type.
+	Details   []json.RawMessage `json:"details"`
+	RequestID string            `json:"requestId,omitempty"`
+}
+
+// ErrorHandler adds the request id to the details of the errors, as a google.rpc.RequestInfo, and writes them in the
+// envelope. The HTTP status and the metadata headers are the same as with the default error handler. Use it with
+// runtime.WithErrorHandler.
+func ErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	id := logging.RequestID(r.Context())
+
+	if id != "" {
+		if st, detailsErr := status.Convert(err).WithDetails(&errdetails.RequestInfo{RequestId: id}); detailsErr == nil {
+			err = st.Err()
+		}
+	}
+
+	runtime.DefaultHTTPErrorHandler(ctx, mux, &envelopeMarshaler{Marshaler: marshaler, requestID: id}, w, r, err)
+}
+
+// envelopeMarshaler marshals the google.rpc.Status of the default error handler in the envelope.
+type envelopeMarshaler struct {
+	runtime.Marshaler
+	requestID string
+}
+
+func (m *envelopeMarshaler) Marshal(v interface{}) ([]byte, error) {
+	st, ok := v.(*spb.Status)
+	if !ok {
+		return m.Marshaler.Marshal(v)
+	}
+
+	envelope := Envelope{
+		Code:      st.Code,
+		Status:    code.Code(st.Code).String(),
+		Message:   st.Message,
+		Details:   []json.RawMessage{},
+		RequestID: m.requestID,
+	}
+
+	for _, detail := range st.Details {
+		b, err := m.Marshaler.Marshal(detail)
+		if err != nil {
+			return nil, err
+		}
+
+		envelope.Details = append(envelope.Details, b)
+	}
+
+	return json.Marshal(envelope)
+}
+
+func (m *envelopeMarshaler) ContentType(v interface{}) string {
+	return "application/json"
+}
@@ -0,0 +1,162 @@
+package apikeys
+
+import (
+	"container/list"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxBuckets bounds the number of buckets kept in memory. Beyond it the least recently used bucket is dropped, which
+// most likely refilled since it was last used, so that its key gets a full bucket again.
+const maxBuckets = 10000
+
+// Limit is the token bucket size and refill rate of a tier.
+type Limit struct {
+	// Rate is the number of requests per second the bucket refills with.
+	Rate float64
+	// Burst is the size of the bucket, i.e. the number of requests that can be made at once.
+	Burst int
+}
+
+// ParseLimits parses a comma separated list of tier limits like "free=1:5,partner=50:100", where 1 is the rate per second
+// and 5 the burst of the free tier. The "default" tier is used for keys with a tier that isn't listed.
+func ParseLimits(value string) (map[string]Limit, error) {
+	limits := map[string]Limit{}
+
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		tier, spec, ok := cut(item, "=")
+		rate, burst, ok2 := cut(spec, ":")
+		if !ok || !ok2 {
+			return nil, fmt.Errorf("invalid rate limit %q, expected tier=rate:burst", item)
+		}
+
+		r, err := strconv.ParseFloat(rate, 64)
+		if err != nil || r <= 0 {
+			return nil, fmt.Errorf("invalid rate in %q, expected a positive number of requests per second", item)
+		}
+
+		b, err := strconv.Atoi(burst)
+		if err != nil || b < 1 {
+			return nil, fmt.Errorf("invalid burst in %q, expected a positive number of requests", item)
+		}
+
+		limits[strings.TrimSpace(tier)] = Limit{Rate: r, Burst: b}
+	}
+
+	if _, ok := limits["default"]; !ok {
+		return nil, fmt.Errorf("the rate limits must include a default tier")
+	}
+
+	return limits, nil
+}
+
+// Decision is the outcome of taking a token from a bucket.
+type Decision struct {
+	Allowed bool
+	Limit   Limit
+	// Remaining is the number of whole tokens left in the bucket.
+	Remaining int
+	// RetryAfter is how long until the next token is available. It's 0 when a token is available now.
+	RetryAfter time.Duration
+	// Reset is how long until the bucket is full again.
+	Reset time.Duration
+}
+
+type bucket struct {
+	id     string
+	tokens float64
+	last   time.Time
+}
+
+// Limiter keeps a token bucket per API key and route, for at most maxBuckets of them.
+type Limiter struct {
+	limits map[string]Limit
+
+	mu      sync.Mutex
+	order   *list.List
+	buckets map[string]*list.Element
+	now     func() time.Time
+}
+
+// NewLimiter instantiates and returns a new Limiter with the given limits per tier.
+func NewLimiter(limits map[string]Limit) *Limiter {
+	return &Limiter{limits: limits, order: list.New(), buckets: map[string]*list.Element{}, now: time.Now}
+}
+
+// Take takes a token from the bucket of the key and route. The route must be a route template, e.g.
+// GET /v1/races/{id}, rather than the path of the request, so that the clients can't make up buckets.
+func (l *Limiter) Take(key *Key, route string) Decision {
+	limit, ok := l.limits[key.Tier]
+	if !ok {
+		limit = l.limits["default"]
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	id := key.ID + " " + route
+
+	b := l.bucket(id, limit, now)
+
+	b.refill(limit, now)
+
+	decision := Decision{Limit: limit}
+
+	if b.tokens >= 1 {
+		b.tokens--
+		decision.Allowed = true
+	} else {
+		decision.RetryAfter = seconds((1 - b.tokens) / limit.Rate)
+	}
+
+	decision.Remaining = int(math.Floor(b.tokens))
+	decision.Reset = seconds((float64(limit.Burst) - b.tokens) / limit.Rate)
+
+	return decision
+}
+
+// bucket returns the bucket with the given id, and makes it the most recently used. A new bucket starts full. It must
+// be called with the lock held.
+func (l *Limiter) bucket(id string, limit Limit, now time.Time) *bucket {
+	if element, ok := l.buckets[id]; ok {
+		l.order.MoveToFront(element)
+		return element.Value.(*bucket)
+	}
+
+	for l.order.Len() >= maxBuckets {
+		delete(l.buckets, l.order.Remove(l.order.Back()).(*bucket).id)
+	}
+
+	b := &bucket{id: id, tokens: float64(limit.Burst), last: now}
+	l.buckets[id] = l.order.PushFront(b)
+
+	return b
+}
+
+func (b *bucket) refill(limit Limit, now time.Time) {
+	b.tokens = math.Min(float64(limit.Burst), b.tokens+now.Sub(b.last).Seconds()*limit.Rate)
+	b.last = now
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
+
+// cut is strings.Cut, which isn't available in Go 1.16.
+func cut(s string, separator string) (string, string, bool) {
+	if i := strings.Index(s, separator); i >= 0 {
+		return s[:i], s[i+len(separator):], true
+	}
+
+	return s, "", false
+}
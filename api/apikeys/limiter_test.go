@@ -0,0 +1,178 @@
+package apikeys
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseLimits(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    map[string]Limit
+		wantErr bool
+	}{
+		{
+			name:  "tiers",
+			value: "default=5:10, free=1:5,partner=0.5:100",
+			want: map[string]Limit{
+				"default": {Rate: 5, Burst: 10},
+				"free":    {Rate: 1, Burst: 5},
+				"partner": {Rate: 0.5, Burst: 100},
+			},
+		},
+		{name: "empty items", value: "default=5:10,,", want: map[string]Limit{"default": {Rate: 5, Burst: 10}}},
+		{name: "no default", value: "free=1:5", wantErr: true},
+		{name: "no burst", value: "default=5", wantErr: true},
+		{name: "no rate", value: "default", wantErr: true},
+		{name: "zero rate", value: "default=0:10", wantErr: true},
+		{name: "zero burst", value: "default=5:0", wantErr: true},
+		{name: "fractional burst", value: "default=5:1.5", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseLimits(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseLimits(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseLimits(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLimiterTake(t *testing.T) {
+	limits := map[string]Limit{
+		"default": {Rate: 1, Burst: 2},
+		"partner": {Rate: 10, Burst: 5},
+	}
+
+	// Each step advances the clock by elapsed and then takes a token from the bucket of the key.
+	type step struct {
+		elapsed       time.Duration
+		key           *Key
+		wantAllowed   bool
+		wantRemaining int
+		wantRetry     time.Duration
+	}
+
+	free := &Key{ID: "free", Tier: "free"}
+	other := &Key{ID: "other", Tier: "free"}
+	partner := &Key{ID: "partner", Tier: "partner"}
+
+	tests := []struct {
+		name  string
+		steps []step
+	}{
+		{
+			name: "burst then refill",
+			steps: []step{
+				{key: free, wantAllowed: true, wantRemaining: 1},
+				{key: free, wantAllowed: true, wantRemaining: 0},
+				{key: free, wantAllowed: false, wantRemaining: 0, wantRetry: time.Second},
+				{elapsed: 500 * time.Millisecond, key: free, wantAllowed: false, wantRemaining: 0, wantRetry: 500 * time.Millisecond},
+				{elapsed: 500 * time.Millisecond, key: free, wantAllowed: true, wantRemaining: 0},
+			},
+		},
+		{
+			name: "refill stops at the burst",
+			steps: []step{
+				{key: free, wantAllowed: true, wantRemaining: 1},
+				{elapsed: time.Hour, key: free, wantAllowed: true, wantRemaining: 1},
+			},
+		},
+		{
+			name: "buckets per key",
+			steps: []step{
+				{key: free, wantAllowed: true, wantRemaining: 1},
+				{key: free, wantAllowed: true, wantRemaining: 0},
+				{key: other, wantAllowed: true, wantRemaining: 1},
+				{key: free, wantAllowed: false, wantRemaining: 0, wantRetry: time.Second},
+			},
+		},
+		{
+			name: "limits of the tier",
+			steps: []step{
+				{key: partner, wantAllowed: true, wantRemaining: 4},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Unix(1600000000, 0)
+
+			l := NewLimiter(limits)
+			l.now = func() time.Time { return now }
+
+			for i, s := range tt.steps {
+				now = now.Add(s.elapsed)
+
+				got := l.Take(s.key, "GET /v1/races")
+				if got.Allowed != s.wantAllowed || got.Remaining != s.wantRemaining || got.RetryAfter != s.wantRetry {
+					t.Errorf("step %d: Take() = allowed %v, remaining %d, retry after %s, want allowed %v, remaining %d, retry after %s",
+						i, got.Allowed, got.Remaining, got.RetryAfter, s.wantAllowed, s.wantRemaining, s.wantRetry)
+				}
+			}
+		})
+	}
+}
+
+func TestLimiterBucketsPerRoute(t *testing.T) {
+	l := NewLimiter(map[string]Limit{"default": {Rate: 1, Burst: 1}})
+	l.now = func() time.Time { return time.Unix(1600000000, 0) }
+
+	key := &Key{ID: "key"}
+
+	if !l.Take(key, "GET /v1/races").Allowed {
+		t.Fatal("first request to GET /v1/races wasn't allowed")
+	}
+
+	if l.Take(key, "GET /v1/races").Allowed {
+		t.Error("second request to GET /v1/races was allowed")
+	}
+
+	if !l.Take(key, "GET /v1/sports").Allowed {
+		t.Error("first request to GET /v1/sports wasn't allowed")
+	}
+}
+
+func TestLimiterEvictsLeastRecentlyUsed(t *testing.T) {
+	l := NewLimiter(map[string]Limit{"default": {Rate: 1, Burst: 1}})
+	l.now = func() time.Time { return time.Unix(1600000000, 0) }
+
+	key := func(i int) *Key { return &Key{ID: fmt.Sprintf("key-%d", i)} }
+
+	for i := 0; i < maxBuckets; i++ {
+		l.Take(key(i), "GET /v1/races")
+	}
+
+	// key-0 becomes the most recently used, so key-1 is evicted by the next bucket.
+	l.Take(key(0), "GET /v1/races")
+	l.Take(key(maxBuckets), "GET /v1/races")
+
+	if got := l.order.Len(); got != maxBuckets {
+		t.Errorf("the limiter keeps %d buckets, want %d", got, maxBuckets)
+	}
+
+	tests := []struct {
+		key         *Key
+		wantAllowed bool
+	}{
+		// The evicted bucket starts full again.
+		{key(1), true},
+		{key(0), false},
+		{key(maxBuckets), false},
+	}
+
+	for _, tt := range tests {
+		if got := l.Take(tt.key, "GET /v1/races").Allowed; got != tt.wantAllowed {
+			t.Errorf("Take(%s) allowed = %v, want %v", tt.key.ID, got, tt.wantAllowed)
+		}
+	}
+}
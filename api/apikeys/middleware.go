@@ -0,0 +1,174 @@
+package apikeys
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"git.neds.sh/matty/entain/api/auth"
+	"git.neds.sh/matty/entain/api/logging"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Header is the request header carrying the API key.
+const Header = "X-API-Key"
+
+// The metadata keys the owner and the tier of an API key are forwarded to the backends with.
+const (
+	OwnerMetadataKey = "api-key-owner"
+	TierMetadataKey  = "api-key-tier"
+)
+
+// QueryParameter is the query parameter carrying the API key of the WebSocket handshakes, as browsers can't set headers on them.
+const QueryParameter = "api_key"
+
+// AnonymousTier is the tier of the rate limits of the requests to the public routes without an API key. They're
+// rate limited per client address.
+const AnonymousTier = "anonymous"
+
+type keyContextKey struct{}
+
+// Config is the configuration of an Authenticator.
+type Config struct {
+	Store   *Store
+	Limiter *Limiter
+	// Route returns the route template of a path, e.g. /v1/races/{id} for /v1/races/1, which the requests are rate limited by.
+	Route func(path string) string
+	// PublicRoutes can be called without an API key, see auth.ParseRoutes.
+	PublicRoutes []auth.Route
+	// BearerTokens must only be true when the requests with a bearer token are authenticated by the JWT authentication.
+	BearerTokens bool
+}
+
+// Authenticator is an HTTP middleware that authenticates API keys and rate limits them per key and route.
+type Authenticator struct {
+	config Config
+	mux    *runtime.ServeMux
+}
+
+// NewAuthenticator instantiates and returns a new Authenticator. The mux is used to write errors in the same format as the gateway.
+func NewAuthenticator(config Config, mux *runtime.ServeMux) *Authenticator {
+	return &Authenticator{config: config, mux: mux}
+}
+
+// Middleware requires a valid API key on every request, except the ones with a bearer token when bearer tokens are accepted
+// and the ones to the public routes, which are rate limited per client address instead.
+//
+// Bearer tokens are for our own clients and are validated by the JWT authentication instead, which must come after this middleware.
+// Every response to a rate limited request has the X-RateLimit-* headers of its bucket.
+func (a *Authenticator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Clients must not be able to forward their own key details to the backends through the gateway's metadata headers.
+		r.Header.Del(runtime.MetadataHeaderPrefix + OwnerMetadataKey)
+		r.Header.Del(runtime.MetadataHeaderPrefix + TierMetadataKey)
+
+		apiKey := r.Header.Get(Header)
+		if apiKey == "" && isWebSocketHandshake(r) {
+			apiKey = takeQueryKey(r)
+		}
+
+		if apiKey == "" {
+			if a.config.BearerTokens && strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if a.public(r) {
+				anonymous := &Key{ID: "anonymous " + logging.RemoteAddr(r), Tier: AnonymousTier}
+				if a.limit(w, r, anonymous) {
+					next.ServeHTTP(w, r)
+				}
+				return
+			}
+
+			a.error(w, r, status.Error(codes.Unauthenticated, "an API key is required in the "+Header+" header"))
+			return
+		}
+
+		key, err := a.config.Store.Authenticate(apiKey)
+		if err != nil {
+			log.Errorf("failed reading the api keys: %s", err)
+			a.error(w, r, status.Error(codes.Unavailable, "API keys can't be checked right now"))
+			return
+		}
+
+		if key == nil {
+			a.error(w, r, status.Error(codes.Unauthenticated, "invalid API key"))
+			return
+		}
+
+		logging.SetCaller(r.Context(), key.Owner)
+
+		if a.limit(w, r, key) {
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), keyContextKey{}, key)))
+		}
+	})
+}
+
+// limit takes a token from the bucket of the key and the route of the request, and sets the X-RateLimit-* headers.
+// It writes the error and returns false when the rate limit is exceeded.
+func (a *Authenticator) limit(w http.ResponseWriter, r *http.Request, key *Key) bool {
+	decision := a.config.Limiter.Take(key, r.Method+" "+a.config.Route(r.URL.Path))
+
+	w.Header().Set("X-RateLimit-Limit", strconv.Itoa(decision.Limit.Burst))
+	w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(decision.Remaining))
+	w.Header().Set("X-RateLimit-Reset", strconv.Itoa(int(math.Ceil(decision.Reset.Seconds()))))
+
+	if !decision.Allowed {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(decision.RetryAfter.Seconds()))))
+		a.error(w, r, status.Error(codes.ResourceExhausted, fmt.Sprintf("rate limit of %g requests per second exceeded, retry later", decision.Limit.Rate)))
+		return false
+	}
+
+	return true
+}
+
+func (a *Authenticator) public(r *http.Request) bool {
+	for _, route := range a.config.PublicRoutes {
+		if route.Matches(r.Method, r.URL.Path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isWebSocketHandshake(r *http.Request) bool {
+	return r.Method == http.MethodGet && strings.EqualFold(r.Header.Get("Upgrade"), "websocket")
+}
+
+// takeQueryKey returns the API key of the query and removes it from the URL, so that it isn't passed down the chain.
+func takeQueryKey(r *http.Request) string {
+	query := r.URL.Query()
+
+	apiKey := query.Get(QueryParameter)
+	if apiKey != "" {
+		query.Del(QueryParameter)
+		r.URL.RawQuery = query.Encode()
+	}
+
+	return apiKey
+}
+
+// error writes the error in the gateway's JSON error format. The gateway maps ResourceExhausted to a 429.
+func (a *Authenticator) error(w http.ResponseWriter, r *http.Request, err error) {
+	_, marshaler := runtime.MarshalerForRequest(a.mux, r)
+	runtime.HTTPError(r.Context(), a.mux, marshaler, w, r, err)
+}
+
+// Metadata forwards the owner and the tier of the API key to the backends. Use it with runtime.WithMetadata.
+func Metadata(ctx context.Context, r *http.Request) metadata.MD {
+	key, ok := r.Context().Value(keyContextKey{}).(*Key)
+	if !ok {
+		return nil
+	}
+
+	return metadata.Pairs(OwnerMetadataKey, key.Owner, TierMetadataKey, key.Tier)
+}
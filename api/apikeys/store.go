@@ -0,0 +1,323 @@
+// Package apikeys authenticates partner integrations with API keys and rate limits them.
+package apikeys
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// keyPrefix starts every API key so that keys are easy to recognise, e.g. by secret scanners.
+const keyPrefix = "ek"
+
+// reloadInterval is how often the store checks whether its file was changed by the admin command.
+const reloadInterval = time.Second
+
+// ErrNotFound is returned for an unknown key id.
+var ErrNotFound = errors.New("api key not found")
+
+// Key is an API key as it's stored. Only a hash of the secret is kept, the key itself is shown once when it's created or rotated.
+type Key struct {
+	ID        string    `json:"id"`
+	Hash      string    `json:"hash"`
+	Owner     string    `json:"owner"`
+	Tier      string    `json:"tier"`
+	Enabled   bool      `json:"enabled"`
+	CreatedAt time.Time `json:"created_at"`
+	RotatedAt time.Time `json:"rotated_at,omitempty"`
+	RevokedAt time.Time `json:"revoked_at,omitempty"`
+}
+
+// Store keeps the API keys in a local JSON file.
+//
+// The gateway and the admin command share the file, so the store reloads it when it changes.
+// That's how a revoked key stops working without restarting the gateway.
+type Store struct {
+	path string
+
+	mu          sync.Mutex
+	keys        map[string]*Key
+	modTime     time.Time
+	lastChecked time.Time
+}
+
+// OpenStore loads the keys from the given file. A missing file is an empty store.
+func OpenStore(path string) (*Store, error) {
+	s := &Store{path: path, keys: map[string]*Key{}}
+
+	if err := s.reload(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Authenticate returns the enabled key matching the given API key. It returns nil if there isn't one.
+func (s *Store) Authenticate(apiKey string) (*Key, error) {
+	id, secret, ok := splitKey(apiKey)
+	if !ok {
+		return nil, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if time.Since(s.lastChecked) >= reloadInterval {
+		if err := s.reloadLocked(); err != nil {
+			return nil, err
+		}
+	}
+
+	key, ok := s.keys[id]
+	if !ok || !key.Enabled || subtle.ConstantTimeCompare([]byte(key.Hash), []byte(hash(secret))) != 1 {
+		return nil, nil
+	}
+
+	copied := *key
+
+	return &copied, nil
+}
+
+// Create adds a new key and returns it along with the API key to hand to its owner.
+func (s *Store) Create(owner string, tier string) (*Key, string, error) {
+	id, err := randomHex(6)
+	if err != nil {
+		return nil, "", err
+	}
+
+	secret, err := randomString(32)
+	if err != nil {
+		return nil, "", err
+	}
+
+	key := &Key{ID: id, Hash: hash(secret), Owner: owner, Tier: tier, Enabled: true, CreatedAt: time.Now().UTC().Truncate(time.Second)}
+
+	return key, formatKey(id, secret), s.update(func(keys map[string]*Key) error {
+		keys[id] = key
+		return nil
+	})
+}
+
+// Rotate replaces the secret of a key. The previous API key stops working straight away.
+func (s *Store) Rotate(id string) (*Key, string, error) {
+	secret, err := randomString(32)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var rotated *Key
+
+	err = s.update(func(keys map[string]*Key) error {
+		key, ok := keys[id]
+		if !ok {
+			return ErrNotFound
+		}
+
+		if !key.Enabled {
+			return fmt.Errorf("api key %s is revoked", id)
+		}
+
+		key.Hash = hash(secret)
+		key.RotatedAt = time.Now().UTC().Truncate(time.Second)
+		rotated = key
+
+		return nil
+	})
+
+	return rotated, formatKey(id, secret), err
+}
+
+// Revoke disables a key. It's kept in the store so that it's known who it belonged to.
+func (s *Store) Revoke(id string) (*Key, error) {
+	var revoked *Key
+
+	err := s.update(func(keys map[string]*Key) error {
+		key, ok := keys[id]
+		if !ok {
+			return ErrNotFound
+		}
+
+		key.Enabled = false
+		key.RevokedAt = time.Now().UTC().Truncate(time.Second)
+		revoked = key
+
+		return nil
+	})
+
+	return revoked, err
+}
+
+// List returns all the keys, the oldest first.
+func (s *Store) List() ([]*Key, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.reloadLocked(); err != nil {
+		return nil, err
+	}
+
+	var keys []*Key
+	for _, key := range s.keys {
+		copied := *key
+		keys = append(keys, &copied)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].CreatedAt.Before(keys[j].CreatedAt) || (keys[i].CreatedAt.Equal(keys[j].CreatedAt) && keys[i].ID < keys[j].ID)
+	})
+
+	return keys, nil
+}
+
+// update reloads the keys, applies the change and saves them.
+func (s *Store) update(change func(keys map[string]*Key) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.reloadLocked(); err != nil {
+		return err
+	}
+
+	if err := change(s.keys); err != nil {
+		return err
+	}
+
+	return s.saveLocked()
+}
+
+func (s *Store) reload() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.reloadLocked()
+}
+
+// reloadLocked reads the file again if it changed since it was last read. It must be called with the lock held.
+func (s *Store) reloadLocked() error {
+	s.lastChecked = time.Now()
+
+	info, err := os.Stat(s.path)
+	if os.IsNotExist(err) {
+		s.keys = map[string]*Key{}
+		s.modTime = time.Time{}
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if info.ModTime().Equal(s.modTime) {
+		return nil
+	}
+
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return err
+	}
+
+	var keys []*Key
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return fmt.Errorf("invalid api keys file %s: %w", s.path, err)
+	}
+
+	s.keys = map[string]*Key{}
+	for _, key := range keys {
+		s.keys[key.ID] = key
+	}
+
+	s.modTime = info.ModTime()
+
+	return nil
+}
+
+// saveLocked writes the keys to a temporary file and renames it, so that the gateway never reads a half written file.
+// It must be called with the lock held.
+func (s *Store) saveLocked() error {
+	var keys []*Key
+	for _, key := range s.keys {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool { return keys[i].ID < keys[j].ID })
+
+	data, err := json.MarshalIndent(keys, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return err
+	}
+
+	// Make sure the next read picks up our own change even if the modification time didn't move.
+	s.modTime = time.Time{}
+
+	return nil
+}
+
+// formatKey builds an API key like ek_<id>_<secret>. The id is used to look the key up, the secret is what's hashed.
+func formatKey(id string, secret string) string {
+	return keyPrefix + "_" + id + "_" + secret
+}
+
+func splitKey(apiKey string) (string, string, bool) {
+	parts := strings.SplitN(apiKey, "_", 3)
+	if len(parts) != 3 || parts[0] != keyPrefix || parts[1] == "" || parts[2] == "" {
+		return "", "", false
+	}
+
+	return parts[1], parts[2], true
+}
+
+func hash(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// randomString returns n random bytes encoded with base64url. It's used for the secrets.
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// randomHex returns n random bytes encoded as hex. It's used for the ids, which can't contain the underscore separating them from the secret.
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,186 @@
+// Package auth authenticates the requests to the API gateway with bearer JWTs.
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"git.neds.sh/matty/entain/api/logging"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// The metadata keys the subject and the scopes of an authenticated caller are forwarded to the backends with.
+// The scopes are space separated.
+const (
+	SubjectMetadataKey = "auth-subject"
+	ScopesMetadataKey  = "auth-scopes"
+)
+
+// Claims are the details of an authenticated caller.
+type Claims struct {
+	Subject string
+	Scopes  []string
+}
+
+type claimsKey struct{}
+
+// Config configures the Authenticator.
+type Config struct {
+	// Keys are used to verify the token signatures.
+	Keys *KeySet
+	// Issuer and Audience are checked against the iss and aud claims when they're set.
+	Issuer   string
+	Audience string
+	// PublicRoutes can be called without a token, see ParseRoutes.
+	PublicRoutes []Route
+}
+
+// Authenticator is an HTTP middleware that validates bearer JWTs.
+type Authenticator struct {
+	config Config
+	mux    *runtime.ServeMux
+	parser *jwt.Parser
+}
+
+// NewAuthenticator instantiates and returns a new Authenticator. The mux is used to write errors in the same format as the gateway.
+func NewAuthenticator(config Config, mux *runtime.ServeMux) *Authenticator {
+	return &Authenticator{
+		config: config,
+		mux:    mux,
+		parser: &jwt.Parser{ValidMethods: []string{"HS256", "RS256"}},
+	}
+}
+
+// Middleware rejects the requests without a valid token, unless they're for a public route.
+//
+// A token sent to a public route is still validated so that a client never mistakes a bad token for a good one.
+// The claims of a valid token are added to the request context, where Metadata picks them up.
+func (a *Authenticator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Clients must not be able to forward their own claims to the backends through the gateway's metadata headers.
+		r.Header.Del(runtime.MetadataHeaderPrefix + SubjectMetadataKey)
+		r.Header.Del(runtime.MetadataHeaderPrefix + ScopesMetadataKey)
+
+		header := r.Header.Get("Authorization")
+		if header == "" {
+			if a.isPublic(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			a.reject(w, r, "", "a bearer token is required")
+			return
+		}
+
+		token := strings.TrimSpace(strings.TrimPrefix(header, "Bearer "))
+		if token == header || token == "" {
+			a.reject(w, r, "invalid_request", "the authorization header must be a bearer token")
+			return
+		}
+
+		claims, err := a.validate(token)
+		if err != nil {
+			a.reject(w, r, "invalid_token", "invalid token: "+err.Error())
+			return
+		}
+
+		logging.SetCaller(r.Context(), claims.Subject)
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), claimsKey{}, claims)))
+	})
+}
+
+// validate checks the signature, the expiry and the issuer and audience of the token and returns its claims.
+func (a *Authenticator) validate(tokenString string) (*Claims, error) {
+	mapClaims := jwt.MapClaims{}
+
+	_, err := a.parser.ParseWithClaims(tokenString, mapClaims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+
+		return a.config.Keys.find(token.Method.Alg(), kid)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Tokens that never expire can't be revoked, so they aren't accepted.
+	if _, ok := mapClaims["exp"]; !ok {
+		return nil, fmt.Errorf("token has no expiry")
+	}
+
+	if a.config.Issuer != "" && !mapClaims.VerifyIssuer(a.config.Issuer, true) {
+		return nil, fmt.Errorf("unexpected issuer")
+	}
+
+	if a.config.Audience != "" && !mapClaims.VerifyAudience(a.config.Audience, true) {
+		return nil, fmt.Errorf("unexpected audience")
+	}
+
+	claims := &Claims{}
+	claims.Subject, _ = mapClaims["sub"].(string)
+	claims.Scopes = scopes(mapClaims)
+
+	return claims, nil
+}
+
+func (a *Authenticator) isPublic(r *http.Request) bool {
+	for _, route := range a.config.PublicRoutes {
+		if route.Matches(r.Method, r.URL.Path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// reject writes an Unauthenticated error in the gateway's JSON error format with a 401 status.
+func (a *Authenticator) reject(w http.ResponseWriter, r *http.Request, code string, message string) {
+	challenge := `Bearer realm="api"`
+	if code != "" {
+		challenge += fmt.Sprintf(`, error=%q, error_description=%q`, code, message)
+	}
+
+	w.Header().Set("WWW-Authenticate", challenge)
+
+	_, marshaler := runtime.MarshalerForRequest(a.mux, r)
+	runtime.HTTPError(r.Context(), a.mux, marshaler, w, r, status.Error(codes.Unauthenticated, message))
+}
+
+// Metadata forwards the subject and the scopes of the caller to the backends. Use it with runtime.WithMetadata.
+func Metadata(ctx context.Context, r *http.Request) metadata.MD {
+	claims, ok := r.Context().Value(claimsKey{}).(*Claims)
+	if !ok {
+		return nil
+	}
+
+	return metadata.Pairs(SubjectMetadataKey, claims.Subject, ScopesMetadataKey, strings.Join(claims.Scopes, " "))
+}
+
+// scopes reads the scopes from the space separated scope claim (RFC 8693) or from the scp claim used by some providers,
+// which can be a list as well.
+func scopes(claims jwt.MapClaims) []string {
+	for _, name := range []string{"scope", "scp"} {
+		switch value := claims[name].(type) {
+		case string:
+			return strings.Fields(value)
+		case []interface{}:
+			var scopes []string
+
+			for _, scope := range value {
+				if s, ok := scope.(string); ok {
+					scopes = append(scopes, s)
+				}
+			}
+
+			return scopes
+		}
+	}
+
+	return nil
+}
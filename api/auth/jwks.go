@@ -0,0 +1,134 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+)
+
+// jwk is a single JSON Web Key (RFC 7517). Only symmetric (oct) keys for HS256 and RSA public keys for RS256 are supported.
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	// K is the base64url encoded secret of an oct key.
+	K string `json:"k"`
+	// N and E are the base64url encoded modulus and exponent of an RSA key.
+	N string `json:"n"`
+	E string `json:"e"`
+}
+
+// key is a verification key along with the algorithm it's used with.
+type key struct {
+	id  string
+	alg string
+	// value is a []byte for HS256 and an *rsa.PublicKey for RS256.
+	value interface{}
+}
+
+// KeySet holds the keys used to verify the signatures of tokens.
+type KeySet struct {
+	keys []*key
+}
+
+// LoadKeySet reads a JWKS file, a JSON object with a "keys" array of JSON Web Keys.
+func LoadKeySet(path string) (*KeySet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var jwks struct {
+		Keys []jwk `json:"keys"`
+	}
+
+	if err := json.Unmarshal(data, &jwks); err != nil {
+		return nil, fmt.Errorf("invalid JWKS file %s: %w", path, err)
+	}
+
+	set := &KeySet{}
+
+	for i, k := range jwks.Keys {
+		// Keys meant for encryption are never used to verify signatures.
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+
+		parsed, err := parseKey(k)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %d (kid %q) in %s: %w", i, k.Kid, path, err)
+		}
+
+		set.keys = append(set.keys, parsed)
+	}
+
+	if len(set.keys) == 0 {
+		return nil, fmt.Errorf("no signing keys found in %s", path)
+	}
+
+	return set, nil
+}
+
+func parseKey(k jwk) (*key, error) {
+	switch k.Kty {
+	case "oct":
+		if k.Alg != "" && k.Alg != "HS256" {
+			return nil, fmt.Errorf("unsupported algorithm %q for an oct key, expected HS256", k.Alg)
+		}
+
+		secret, err := base64.RawURLEncoding.DecodeString(k.K)
+		if err != nil || len(secret) == 0 {
+			return nil, fmt.Errorf("k must be a non empty base64url encoded secret")
+		}
+
+		return &key{id: k.Kid, alg: "HS256", value: secret}, nil
+	case "RSA":
+		if k.Alg != "" && k.Alg != "RS256" {
+			return nil, fmt.Errorf("unsupported algorithm %q for an RSA key, expected RS256", k.Alg)
+		}
+
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil || len(n) == 0 {
+			return nil, fmt.Errorf("n must be a base64url encoded modulus")
+		}
+
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil || len(e) == 0 || len(e) > 4 {
+			return nil, fmt.Errorf("e must be a base64url encoded exponent")
+		}
+
+		exponent := int(new(big.Int).SetBytes(e).Int64())
+
+		return &key{id: k.Kid, alg: "RS256", value: &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: exponent}}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q, expected oct or RSA", k.Kty)
+	}
+}
+
+// find returns the key to verify a token signed with the given algorithm and key id.
+// Tokens without a key id can only be verified when there's a single key for their algorithm.
+// The algorithm has to match the key so that an RSA public key can never be used as an HMAC secret.
+func (s *KeySet) find(alg string, kid string) (interface{}, error) {
+	var candidates []*key
+
+	for _, k := range s.keys {
+		if k.alg == alg && (kid == "" || k.id == kid) {
+			candidates = append(candidates, k)
+		}
+	}
+
+	switch {
+	case len(candidates) == 0:
+		return nil, fmt.Errorf("no %s key found with kid %q", alg, kid)
+	case len(candidates) > 1 && kid == "":
+		return nil, fmt.Errorf("the token must have a kid, there are several %s keys", alg)
+	case len(candidates) > 1:
+		return nil, fmt.Errorf("several %s keys have kid %q", alg, kid)
+	}
+
+	return candidates[0].value, nil
+}
@@ -0,0 +1,78 @@
+package auth
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Route is a pattern matching the method and the path of a request.
+type Route struct {
+	// Method is empty when any method matches.
+	Method   string
+	Segments []string
+}
+
+// ParseRoutes parses a comma separated list of routes like "GET /v1/races/*,POST /v1/list-races".
+//
+// The method is optional. In the path, * matches a single segment and a trailing ** matches the rest of the path.
+func ParseRoutes(value string) ([]Route, error) {
+	var routes []Route
+
+	for _, pattern := range strings.Split(value, ",") {
+		fields := strings.Fields(pattern)
+
+		var route Route
+
+		switch len(fields) {
+		case 0:
+			continue
+		case 1:
+			route.Segments = segments(fields[0])
+		case 2:
+			route.Method = strings.ToUpper(fields[0])
+			route.Segments = segments(fields[1])
+		default:
+			return nil, fmt.Errorf("invalid route %q, expected [METHOD] /path", pattern)
+		}
+
+		if !strings.HasPrefix(fields[len(fields)-1], "/") {
+			return nil, fmt.Errorf("invalid route %q, the path must start with /", pattern)
+		}
+
+		for i, segment := range route.Segments {
+			if segment == "**" && i != len(route.Segments)-1 {
+				return nil, fmt.Errorf("invalid route %q, ** is only allowed at the end", pattern)
+			}
+		}
+
+		routes = append(routes, route)
+	}
+
+	return routes, nil
+}
+
+// Matches reports whether the route matches the method and the path of a request. GET routes match HEAD requests as well.
+func (r Route) Matches(method string, path string) bool {
+	if r.Method != "" && r.Method != method && !(r.Method == http.MethodGet && method == http.MethodHead) {
+		return false
+	}
+
+	parts := segments(path)
+
+	for i, segment := range r.Segments {
+		if segment == "**" {
+			return true
+		}
+
+		if i >= len(parts) || (segment != "*" && segment != parts[i]) {
+			return false
+		}
+	}
+
+	return len(parts) == len(r.Segments)
+}
+
+func segments(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
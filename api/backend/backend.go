@@ -0,0 +1,120 @@
+// Package backend configures the connections of the gateway to the racing and sports services: the balancing of the calls
+// between their replicas, the default deadline of the calls, the retries of the reads and a circuit breaker per backend.
+package backend
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// Config is the configuration of the connections to the backends.
+type Config struct {
+	// Timeout is the deadline of the calls that don't have one, e.g. from a route timeout or a grpc-timeout header.
+	// Streams of updates aren't given one.
+	Timeout time.Duration
+	// MaxAttempts is the number of times a read is tried when the backend is unavailable. Reads aren't retried with 1.
+	MaxAttempts int
+	// BreakerFailures is the number of failures in a row that trip the circuit breaker of a backend.
+	BreakerFailures int
+	// BreakerCooldown is how long a tripped circuit breaker fails the calls fast before letting one through again.
+	BreakerCooldown time.Duration
+	// EjectFailures is the number of failures in a row that eject an endpoint of a backend. They're never ejected with 0.
+	EjectFailures int
+	// EjectDuration is how long an ejected endpoint is left out of the calls.
+	EjectDuration time.Duration
+}
+
+// DialOptions returns the dial options of the connection to a backend, e.g. racing, serving the given gRPC service,
+// e.g. racing.Racing. The service must be registered with the protobuf registry, i.e. its generated package imported.
+//
+// The calls are balanced round robin between the endpoints of the target, see Target, that pass the gRPC health check
+// of the service and aren't ejected.
+func DialOptions(name string, service string, config Config) ([]grpc.DialOption, error) {
+	serviceConfig, err := backendServiceConfig(name, service, config.MaxAttempts)
+	if err != nil {
+		return nil, err
+	}
+
+	register(newEndpoints(name, config.EjectFailures, config.EjectDuration))
+
+	breaker := NewBreaker(name, config.BreakerFailures, config.BreakerCooldown)
+	timeout := &timeout{timeout: config.Timeout}
+
+	// The breaker is innermost so that it only sees the outcome of a call once its retries are done.
+	return []grpc.DialOption{
+		grpc.WithDefaultServiceConfig(serviceConfig),
+		grpc.WithChainUnaryInterceptor(timeout.unary, breaker.unary),
+		grpc.WithChainStreamInterceptor(timeout.stream, breaker.stream),
+	}, nil
+}
+
+// RoundRobin returns the dial option balancing the calls round robin between the endpoints of the target passing the
+// gRPC health check of the service, without the ejections and the debug endpoint of DialOptions.
+func RoundRobin(service string) grpc.DialOption {
+	config, _ := json.Marshal(map[string]interface{}{
+		"loadBalancingConfig": []map[string]interface{}{{"round_robin": map[string]interface{}{}}},
+		"healthCheckConfig":   map[string]string{"serviceName": service},
+	})
+
+	return grpc.WithDefaultServiceConfig(string(config))
+}
+
+// backendServiceConfig returns the gRPC service config of a backend: its balancer, the health check of its endpoints
+// and the retries of its reads.
+//
+// The reads of the service, i.e. its List and Get methods, are retried when the backend answers Unavailable or the
+// connection drops during the call, e.g. the backend shutting down. The calls failing as the backend can't be reached
+// at all aren't retried, the breaker fails them fast instead. The writes aren't retried as they aren't idempotent.
+func backendServiceConfig(backend string, service string, maxAttempts int) (string, error) {
+	d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(service))
+	if err != nil {
+		return "", fmt.Errorf("service %s: %w", service, err)
+	}
+
+	desc, ok := d.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return "", fmt.Errorf("%s is not a service", service)
+	}
+
+	type name struct {
+		Service string `json:"service"`
+		Method  string `json:"method"`
+	}
+
+	var names []name
+	for i := 0; i < desc.Methods().Len(); i++ {
+		method := string(desc.Methods().Get(i).Name())
+
+		if strings.HasPrefix(method, "List") || strings.HasPrefix(method, "Get") {
+			names = append(names, name{Service: service, Method: method})
+		}
+	}
+
+	config := map[string]interface{}{
+		"loadBalancingConfig": []map[string]interface{}{{roundRobinName: roundRobinConfig{Backend: backend}}},
+		"healthCheckConfig":   map[string]string{"serviceName": service},
+	}
+
+	if maxAttempts >= 2 && len(names) > 0 {
+		config["methodConfig"] = []map[string]interface{}{{
+			"name": names,
+			"retryPolicy": map[string]interface{}{
+				"maxAttempts":          maxAttempts,
+				"initialBackoff":       "0.1s",
+				"maxBackoff":           "1s",
+				"backoffMultiplier":    2,
+				"retryableStatusCodes": []string{"UNAVAILABLE"},
+			},
+		}}
+	}
+
+	b, err := json.Marshal(config)
+
+	return string(b), err
+}
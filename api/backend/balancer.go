@@ -0,0 +1,168 @@
+package backend
+
+import (
+	"encoding/json"
+	"math/rand"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/balancer"
+	"google.golang.org/grpc/balancer/base"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/serviceconfig"
+
+	// The health package registers the client side health checks of the endpoints, see the healthCheckConfig of the
+	// service config.
+	_ "google.golang.org/grpc/health"
+)
+
+// roundRobinName is the name of the balancer of the backends in their service config.
+const roundRobinName = "entain_round_robin"
+
+func init() {
+	rand.Seed(time.Now().UnixNano())
+	balancer.Register(&roundRobinBuilder{})
+}
+
+// roundRobinConfig is the config of the balancer in the service config, e.g. {"backend": "racing"}.
+type roundRobinConfig struct {
+	serviceconfig.LoadBalancingConfig `json:"-"`
+	// Backend is the name of the endpoints the balancer keeps up to date, see DialOptions.
+	Backend string `json:"backend"`
+}
+
+// roundRobinBuilder builds the balancer of the backends. It's the round_robin of gRPC, i.e. a connection to each of
+// the endpoints and the calls sent to the ready ones in turn, with the endpoints failing the calls ejected for a while.
+type roundRobinBuilder struct{}
+
+func (b *roundRobinBuilder) Name() string {
+	return roundRobinName
+}
+
+func (b *roundRobinBuilder) ParseConfig(js json.RawMessage) (serviceconfig.LoadBalancingConfig, error) {
+	config := &roundRobinConfig{}
+	if err := json.Unmarshal(js, config); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+func (b *roundRobinBuilder) Build(cc balancer.ClientConn, opts balancer.BuildOptions) balancer.Balancer {
+	rr := &roundRobin{endpoints: newEndpoints("", 0, 0)}
+	rr.Balancer = base.NewBalancerBuilder(roundRobinName, rr, base.Config{HealthCheck: true}).Build(&roundRobinConn{ClientConn: cc, rr: rr}, opts)
+
+	return rr
+}
+
+// roundRobin is the base balancer of gRPC, which connects to the endpoints, keeping track of the endpoints and their
+// state on the way.
+type roundRobin struct {
+	balancer.Balancer
+	endpoints *endpoints
+}
+
+func (rr *roundRobin) UpdateClientConnState(state balancer.ClientConnState) error {
+	if config, ok := state.BalancerConfig.(*roundRobinConfig); ok {
+		if e := lookup(config.Backend); e != nil && e != rr.endpoints {
+			rr.endpoints.reset()
+			rr.endpoints = e
+		}
+	}
+
+	return rr.Balancer.UpdateClientConnState(state)
+}
+
+func (rr *roundRobin) UpdateSubConnState(sc balancer.SubConn, state balancer.SubConnState) {
+	rr.endpoints.setState(sc, state)
+	rr.Balancer.UpdateSubConnState(sc, state)
+}
+
+func (rr *roundRobin) ExitIdle() {
+	if b, ok := rr.Balancer.(balancer.ExitIdler); ok {
+		b.ExitIdle()
+	}
+}
+
+func (rr *roundRobin) Close() {
+	rr.endpoints.reset()
+	rr.Balancer.Close()
+}
+
+// Build builds the picker of the ready endpoints, see base.PickerBuilder.
+func (rr *roundRobin) Build(info base.PickerBuildInfo) balancer.Picker {
+	if len(info.ReadySCs) == 0 {
+		return base.NewErrPicker(balancer.ErrNoSubConnAvailable)
+	}
+
+	p := &picker{endpoints: rr.endpoints}
+	for sc, sci := range info.ReadySCs {
+		p.subConns = append(p.subConns, sc)
+		p.addresses = append(p.addresses, sci.Address.Addr)
+	}
+
+	// Starting at random spreads the calls of the gateways restarting at the same time.
+	p.next = uint32(rand.Intn(len(p.subConns)))
+
+	return p
+}
+
+// roundRobinConn keeps track of the connections the base balancer makes to the endpoints.
+type roundRobinConn struct {
+	balancer.ClientConn
+	rr *roundRobin
+}
+
+func (c *roundRobinConn) NewSubConn(addresses []resolver.Address, opts balancer.NewSubConnOptions) (balancer.SubConn, error) {
+	sc, err := c.ClientConn.NewSubConn(addresses, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	c.rr.endpoints.add(sc, addresses[0].Addr)
+
+	return sc, nil
+}
+
+func (c *roundRobinConn) RemoveSubConn(sc balancer.SubConn) {
+	c.rr.endpoints.remove(sc)
+	c.ClientConn.RemoveSubConn(sc)
+}
+
+func (c *roundRobinConn) UpdateState(state balancer.State) {
+	c.rr.endpoints.setBackendState(state.ConnectivityState)
+	c.ClientConn.UpdateState(state)
+}
+
+// picker sends the calls to the ready endpoints in turn, skipping the ejected ones.
+type picker struct {
+	endpoints *endpoints
+	subConns  []balancer.SubConn
+	addresses []string
+	next      uint32
+}
+
+func (p *picker) Pick(balancer.PickInfo) (balancer.PickResult, error) {
+	n := uint32(len(p.subConns))
+	next := atomic.AddUint32(&p.next, 1)
+
+	for i := uint32(0); i < n; i++ {
+		if j := (next + i) % n; !p.endpoints.ejected(p.addresses[j]) {
+			return p.result(j), nil
+		}
+	}
+
+	// The ready endpoints are used even when they're all ejected, rather than failing all the calls.
+	return p.result(next % n), nil
+}
+
+func (p *picker) result(i uint32) balancer.PickResult {
+	address := p.addresses[i]
+
+	return balancer.PickResult{
+		SubConn: p.subConns[i],
+		Done: func(info balancer.DoneInfo) {
+			p.endpoints.record(address, info.Err)
+		},
+	}
+}
@@ -0,0 +1,152 @@
+package backend
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"git.neds.sh/matty/entain/api/metrics"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// The states of a circuit breaker, which are also the values of its metric.
+const (
+	closed   = 0
+	halfOpen = 1
+	open     = 2
+)
+
+var stateNames = map[int]string{closed: "closed", halfOpen: "half-open", open: "open"}
+
+// Breaker is the circuit breaker of a backend. It trips after a number of calls in a row fail because the backend is
+// unavailable or too slow, and then fails the calls fast with Unavailable, i.e. a 503, rather than letting them pile up.
+// Once the cooldown is over, a single call is let through to probe the backend, which closes the breaker again when it
+// succeeds.
+type Breaker struct {
+	name     string
+	failures int
+	cooldown time.Duration
+
+	mu       sync.Mutex
+	state    int
+	failed   int
+	openedAt time.Time
+	probing  bool
+	now      func() time.Time
+}
+
+// NewBreaker instantiates and returns a new Breaker for the named backend, e.g. racing. It never trips with 0 failures.
+func NewBreaker(name string, failures int, cooldown time.Duration) *Breaker {
+	b := &Breaker{name: name, failures: failures, cooldown: cooldown, now: time.Now}
+	metrics.SetCircuitBreakerState(name, closed)
+
+	return b
+}
+
+func (b *Breaker) unary(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if err := b.allow(); err != nil {
+		return err
+	}
+
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	b.record(ctx, err)
+
+	return err
+}
+
+// stream only records whether the streams start, the streams of updates are long lived.
+func (b *Breaker) stream(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	if err := b.allow(); err != nil {
+		return nil, err
+	}
+
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	b.record(ctx, err)
+
+	return stream, err
+}
+
+// allow returns an Unavailable error when the breaker is open, or half open with a probe in flight.
+func (b *Breaker) allow() error {
+	if b.failures <= 0 {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == open {
+		left := b.cooldown - b.now().Sub(b.openedAt)
+		if left > 0 {
+			metrics.CircuitBreakerRejected(b.name)
+			return status.Errorf(codes.Unavailable, "%s is unavailable, retry in %s", b.name, left.Round(time.Second))
+		}
+
+		b.setState(halfOpen)
+	}
+
+	if b.state == halfOpen {
+		if b.probing {
+			metrics.CircuitBreakerRejected(b.name)
+			return status.Errorf(codes.Unavailable, "%s is unavailable, retry shortly", b.name)
+		}
+
+		b.probing = true
+	}
+
+	return nil
+}
+
+// record counts the failures of the calls. Only the backend being down or too slow counts, not the errors of the
+// requests, e.g. NotFound, nor the calls canceled by the clients.
+func (b *Breaker) record(ctx context.Context, err error) {
+	if b.failures <= 0 {
+		return
+	}
+
+	code := status.Code(err)
+	failed := (code == codes.Unavailable || code == codes.DeadlineExceeded) && ctx.Err() != context.Canceled
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == halfOpen {
+		b.probing = false
+	}
+
+	if !failed {
+		b.failed = 0
+
+		if b.state != closed {
+			b.setState(closed)
+		}
+
+		return
+	}
+
+	b.failed++
+
+	if b.state == halfOpen || b.failed >= b.failures {
+		b.openedAt = b.now()
+
+		if b.state != open {
+			b.setState(open)
+		}
+	}
+}
+
+// setState must be called with the lock held.
+func (b *Breaker) setState(state int) {
+	logger := log.WithField("backend", b.name)
+	if state == closed {
+		logger.Infof("circuit breaker %s", stateNames[state])
+	} else {
+		logger.Warnf("circuit breaker %s", stateNames[state])
+	}
+
+	b.state = state
+	metrics.SetCircuitBreakerState(b.name, state)
+}
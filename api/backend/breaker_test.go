@@ -0,0 +1,155 @@
+package backend
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBreaker(t *testing.T) {
+	// Each step advances the clock by elapsed and then makes a call that fails with code, unless the breaker rejects it.
+	type step struct {
+		elapsed      time.Duration
+		code         codes.Code
+		canceled     bool
+		wantRejected bool
+		wantState    int
+	}
+
+	tests := []struct {
+		name     string
+		failures int
+		steps    []step
+	}{
+		{
+			name:     "trips after the failures in a row",
+			failures: 3,
+			steps: []step{
+				{code: codes.Unavailable, wantState: closed},
+				{code: codes.DeadlineExceeded, wantState: closed},
+				{code: codes.Unavailable, wantState: open},
+				{code: codes.OK, wantRejected: true, wantState: open},
+			},
+		},
+		{
+			name:     "success resets the failures",
+			failures: 2,
+			steps: []step{
+				{code: codes.Unavailable, wantState: closed},
+				{code: codes.OK, wantState: closed},
+				{code: codes.Unavailable, wantState: closed},
+			},
+		},
+		{
+			name:     "errors of the requests don't count",
+			failures: 1,
+			steps: []step{
+				{code: codes.NotFound, wantState: closed},
+				{code: codes.InvalidArgument, wantState: closed},
+				{code: codes.Internal, wantState: closed},
+			},
+		},
+		{
+			name:     "calls canceled by the clients don't count",
+			failures: 1,
+			steps: []step{
+				{code: codes.DeadlineExceeded, canceled: true, wantState: closed},
+			},
+		},
+		{
+			name:     "successful probe closes",
+			failures: 1,
+			steps: []step{
+				{code: codes.Unavailable, wantState: open},
+				{elapsed: 9 * time.Second, wantRejected: true, wantState: open},
+				{elapsed: time.Second, code: codes.OK, wantState: closed},
+				{code: codes.OK, wantState: closed},
+			},
+		},
+		{
+			name:     "failed probe opens again for another cooldown",
+			failures: 2,
+			steps: []step{
+				{code: codes.Unavailable, wantState: closed},
+				{code: codes.Unavailable, wantState: open},
+				{elapsed: 10 * time.Second, code: codes.Unavailable, wantState: open},
+				{elapsed: 9 * time.Second, wantRejected: true, wantState: open},
+				{elapsed: time.Second, code: codes.OK, wantState: closed},
+			},
+		},
+		{
+			name:     "never trips with 0 failures",
+			failures: 0,
+			steps: []step{
+				{code: codes.Unavailable, wantState: closed},
+				{code: codes.Unavailable, wantState: closed},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Unix(1600000000, 0)
+
+			b := NewBreaker("test", tt.failures, 10*time.Second)
+			b.now = func() time.Time { return now }
+
+			for i, s := range tt.steps {
+				now = now.Add(s.elapsed)
+
+				ctx, cancel := context.WithCancel(context.Background())
+				if s.canceled {
+					cancel()
+				}
+
+				invoked := false
+				err := b.unary(ctx, "/racing.Racing/ListRaces", nil, nil, nil, func(context.Context, string, interface{}, interface{}, *grpc.ClientConn, ...grpc.CallOption) error {
+					invoked = true
+					return status.Error(s.code, s.code.String())
+				})
+				cancel()
+
+				if invoked == s.wantRejected {
+					t.Errorf("step %d: call invoked = %v, want %v", i, invoked, !s.wantRejected)
+				}
+
+				if s.wantRejected && status.Code(err) != codes.Unavailable {
+					t.Errorf("step %d: rejected call error = %v, want Unavailable", i, err)
+				}
+
+				if b.state != s.wantState {
+					t.Errorf("step %d: state = %s, want %s", i, stateNames[b.state], stateNames[s.wantState])
+				}
+			}
+		})
+	}
+}
+
+func TestBreakerSingleProbe(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+
+	b := NewBreaker("test", 1, 10*time.Second)
+	b.now = func() time.Time { return now }
+
+	b.record(context.Background(), status.Error(codes.Unavailable, "down"))
+	now = now.Add(10 * time.Second)
+
+	if err := b.allow(); err != nil {
+		t.Fatalf("allow() of the probe = %v, want nil", err)
+	}
+
+	// The other calls are rejected while the probe is in flight.
+	if err := b.allow(); status.Code(err) != codes.Unavailable {
+		t.Errorf("allow() during the probe = %v, want Unavailable", err)
+	}
+
+	b.record(context.Background(), nil)
+
+	if err := b.allow(); err != nil {
+		t.Errorf("allow() after the probe = %v, want nil", err)
+	}
+}
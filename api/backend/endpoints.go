@@ -0,0 +1,256 @@
+package backend
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/balancer"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/status"
+)
+
+// registry holds the endpoints of the backends by name, for their balancer and the debug endpoint.
+var registry = struct {
+	sync.Mutex
+	endpoints map[string]*endpoints
+}{endpoints: map[string]*endpoints{}}
+
+func register(e *endpoints) {
+	registry.Lock()
+	defer registry.Unlock()
+
+	registry.endpoints[e.name] = e
+}
+
+func lookup(name string) *endpoints {
+	registry.Lock()
+	defer registry.Unlock()
+
+	return registry.endpoints[name]
+}
+
+// endpoints is the state of the endpoints of a backend, i.e. its replicas. An endpoint is ejected for a while after a
+// number of calls in a row fail because it's unavailable or too slow, as its health check can pass nonetheless.
+type endpoints struct {
+	name     string
+	failures int
+	ejection time.Duration
+	now      func() time.Time
+
+	mu        sync.Mutex
+	state     connectivity.State
+	subConns  map[balancer.SubConn]string
+	endpoints map[string]*endpoint
+}
+
+type endpoint struct {
+	state connectivity.State
+	err   error
+	// unavailable tells whether the endpoint failed since it was last ready, through its attempts to reconnect.
+	unavailable  bool
+	failed       int
+	calls        uint64
+	ejectedUntil time.Time
+}
+
+// newEndpoints instantiates and returns new endpoints. They're never ejected with 0 failures.
+func newEndpoints(name string, failures int, ejection time.Duration) *endpoints {
+	return &endpoints{
+		name:      name,
+		failures:  failures,
+		ejection:  ejection,
+		now:       time.Now,
+		state:     connectivity.Idle,
+		subConns:  map[balancer.SubConn]string{},
+		endpoints: map[string]*endpoint{},
+	}
+}
+
+func (e *endpoints) add(sc balancer.SubConn, address string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.subConns[sc] = address
+	e.endpoints[address] = &endpoint{state: connectivity.Idle}
+}
+
+func (e *endpoints) remove(sc balancer.SubConn) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	delete(e.endpoints, e.subConns[sc])
+	delete(e.subConns, sc)
+}
+
+// reset forgets the endpoints when their balancer is closed.
+func (e *endpoints) reset() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.state = connectivity.Idle
+	e.subConns = map[balancer.SubConn]string{}
+	e.endpoints = map[string]*endpoint{}
+}
+
+func (e *endpoints) setBackendState(state connectivity.State) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.state = state
+}
+
+// setState records the state of the connection to an endpoint, which is TRANSIENT_FAILURE when it can't be reached or
+// its health check fails. Only the failures and the recoveries are logged.
+func (e *endpoints) setState(sc balancer.SubConn, state balancer.SubConnState) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	address, ok := e.subConns[sc]
+	if !ok {
+		return
+	}
+
+	endpoint := e.endpoints[address]
+	logger := log.WithField("backend", e.name).WithField("endpoint", address)
+
+	switch {
+	case state.ConnectivityState == connectivity.TransientFailure && !endpoint.unavailable:
+		endpoint.unavailable = true
+		logger.Warnf("endpoint unavailable: %s", state.ConnectionError)
+	case state.ConnectivityState == connectivity.Ready && endpoint.unavailable:
+		endpoint.unavailable = false
+		logger.Info("endpoint available")
+	}
+
+	endpoint.state = state.ConnectivityState
+	endpoint.err = state.ConnectionError
+}
+
+func (e *endpoints) ejected(address string) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	endpoint, ok := e.endpoints[address]
+
+	return ok && e.now().Before(endpoint.ejectedUntil)
+}
+
+// record counts the failures of the calls to an endpoint, like the circuit breaker of the backend, and ejects it after
+// too many in a row.
+func (e *endpoints) record(address string, err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	endpoint, ok := e.endpoints[address]
+	if !ok {
+		return
+	}
+
+	endpoint.calls++
+
+	switch status.Code(err) {
+	case codes.Canceled:
+		return
+	case codes.Unavailable, codes.DeadlineExceeded:
+		endpoint.failed++
+	default:
+		endpoint.failed = 0
+		return
+	}
+
+	if e.failures <= 0 || endpoint.failed < e.failures || e.now().Before(endpoint.ejectedUntil) {
+		return
+	}
+
+	endpoint.ejectedUntil = e.now().Add(e.ejection)
+	endpoint.failed = 0
+
+	log.WithField("backend", e.name).WithField("endpoint", address).
+		Warnf("endpoint ejected for %s after %d failed calls in a row", e.ejection, e.failures)
+}
+
+// Status is the body of the debug endpoint of the backends.
+type Status struct {
+	Backends map[string]BackendStatus `json:"backends"`
+}
+
+// BackendStatus is the state of a backend in a Status.
+type BackendStatus struct {
+	// State is the state of the connection to the backend, e.g. READY as long as one of its endpoints is.
+	State     string           `json:"state"`
+	Endpoints []EndpointStatus `json:"endpoints"`
+}
+
+// EndpointStatus is the state of an endpoint of a backend in a Status.
+type EndpointStatus struct {
+	Address string `json:"address"`
+	// State is the state of the connection to the endpoint: IDLE, CONNECTING, READY or TRANSIENT_FAILURE when it can't
+	// be reached or isn't serving.
+	State string `json:"state"`
+	// Error tells why the endpoint is in TRANSIENT_FAILURE.
+	Error string `json:"error,omitempty"`
+	// Ejected tells whether the endpoint is left out of the calls, until EjectedUntil, after too many failed calls.
+	Ejected      bool       `json:"ejected"`
+	EjectedUntil *time.Time `json:"ejectedUntil,omitempty"`
+	// Failures is the number of calls that failed in a row since the last ejection.
+	Failures int    `json:"failures"`
+	Calls    uint64 `json:"calls"`
+}
+
+func (e *endpoints) status() BackendStatus {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	backend := BackendStatus{State: e.state.String(), Endpoints: []EndpointStatus{}}
+
+	for address, endpoint := range e.endpoints {
+		s := EndpointStatus{
+			Address:  address,
+			State:    endpoint.state.String(),
+			Failures: endpoint.failed,
+			Calls:    endpoint.calls,
+		}
+
+		if endpoint.err != nil && endpoint.state == connectivity.TransientFailure {
+			s.Error = endpoint.err.Error()
+		}
+
+		if e.now().Before(endpoint.ejectedUntil) {
+			until := endpoint.ejectedUntil.UTC()
+			s.Ejected, s.EjectedUntil = true, &until
+		}
+
+		backend.Endpoints = append(backend.Endpoints, s)
+	}
+
+	sort.Slice(backend.Endpoints, func(i, j int) bool {
+		return backend.Endpoints[i].Address < backend.Endpoints[j].Address
+	})
+
+	return backend
+}
+
+// Handler serves the state of the endpoints of the backends, see Status.
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report := Status{Backends: map[string]BackendStatus{}}
+
+		registry.Lock()
+		for name, e := range registry.endpoints {
+			report.Backends[name] = e.status()
+		}
+		registry.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+
+		if err := json.NewEncoder(w).Encode(report); err != nil {
+			log.Debugf("failed writing backends status: %s", err)
+		}
+	})
+}
@@ -0,0 +1,69 @@
+package backend
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc/resolver"
+)
+
+// staticScheme is the scheme of the targets listing the addresses of the replicas of a backend.
+const staticScheme = "static"
+
+func init() {
+	resolver.Register(&staticBuilder{})
+}
+
+// Target returns the gRPC target of the endpoints of a backend. They're either a comma separated list of addresses, e.g.
+// racing-1:9000,racing-2:9000, or a single target, e.g. localhost:9000, or dns:///racing:9000 to balance the calls
+// between all the addresses the name resolves to.
+func Target(endpoints string) string {
+	endpoints = strings.TrimSpace(endpoints)
+	if !strings.Contains(endpoints, ",") {
+		return endpoints
+	}
+
+	return staticScheme + ":///" + endpoints
+}
+
+// staticBuilder resolves the targets listing addresses, e.g. static:///racing-1:9000,racing-2:9000, to these addresses.
+type staticBuilder struct{}
+
+func (b *staticBuilder) Build(target resolver.Target, cc resolver.ClientConn, _ resolver.BuildOptions) (resolver.Resolver, error) {
+	var addresses []resolver.Address
+
+	// Each address has its own server name, for the TLS handshake, as the authority of the target is the whole list.
+	for _, address := range strings.Split(target.Endpoint, ",") {
+		if address = strings.TrimSpace(address); address == "" {
+			continue
+		}
+
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			host = address
+		}
+
+		addresses = append(addresses, resolver.Address{Addr: address, ServerName: host})
+	}
+
+	if len(addresses) == 0 {
+		return nil, fmt.Errorf("invalid target %q, expected a comma separated list of addresses", target.Endpoint)
+	}
+
+	// The error is the balancer rejecting the addresses, which it reports in its own state.
+	_ = cc.UpdateState(resolver.State{Addresses: addresses})
+
+	return staticResolver{}, nil
+}
+
+func (b *staticBuilder) Scheme() string {
+	return staticScheme
+}
+
+// staticResolver has nothing to do, the addresses never change.
+type staticResolver struct{}
+
+func (staticResolver) ResolveNow(resolver.ResolveNowOptions) {}
+
+func (staticResolver) Close() {}
@@ -0,0 +1,47 @@
+package backend
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// The backoff between the reconnections of a backend stream. It doubles after each failure.
+const (
+	minBackoff = time.Second
+	maxBackoff = 30 * time.Second
+)
+
+// Stream runs a backend stream until the context is done, reconnecting it with a backoff whenever it fails.
+// The stream calls connected once it receives an update, which resets the backoff. Stream gives up on the errors that
+// won't go away by reconnecting, e.g. an unknown sports event, and returns them.
+func Stream(ctx context.Context, name string, run func(connected func()) error) error {
+	backoff := minBackoff
+
+	for {
+		err := run(func() { backoff = minBackoff })
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		switch status.Code(err) {
+		case codes.NotFound, codes.InvalidArgument:
+			return err
+		}
+
+		log.Warnf("the %s stream failed, reconnecting in %s: %s", name, backoff, err)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(backoff):
+		}
+
+		if backoff *= 2; backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
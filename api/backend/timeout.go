@@ -0,0 +1,127 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"git.neds.sh/matty/entain/api/auth"
+	"google.golang.org/grpc"
+)
+
+// timeout gives the calls without a deadline the default one.
+type timeout struct {
+	timeout time.Duration
+}
+
+func (t *timeout) unary(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if _, ok := ctx.Deadline(); ok || t.timeout <= 0 {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, t.timeout)
+	defer cancel()
+
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// stream only gives a deadline to the unary calls made as streams, e.g. by the gRPC-Web and Connect routes, not to the
+// streams of updates.
+func (t *timeout) stream(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	if _, ok := ctx.Deadline(); ok || t.timeout <= 0 || desc.ServerStreams || desc.ClientStreams {
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, t.timeout)
+
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	return &unaryStream{ClientStream: stream, cancel: cancel}, nil
+}
+
+// unaryStream releases the deadline of a unary call made as a stream once its response is received, which ends the call.
+type unaryStream struct {
+	grpc.ClientStream
+	cancel context.CancelFunc
+}
+
+func (s *unaryStream) RecvMsg(m interface{}) error {
+	defer s.cancel()
+
+	return s.ClientStream.RecvMsg(m)
+}
+
+// RouteTimeout is the deadline of the backend calls of a route.
+type RouteTimeout struct {
+	Route   auth.Route
+	Timeout time.Duration
+}
+
+// ParseRouteTimeouts parses a comma separated list of routes and their timeout like "GET /v1/upcoming=3s". The routes
+// are the same as the public routes of the authentication, see auth.ParseRoutes.
+func ParseRouteTimeouts(value string) ([]RouteTimeout, error) {
+	var timeouts []RouteTimeout
+
+	for _, item := range strings.Split(value, ",") {
+		if strings.TrimSpace(item) == "" {
+			continue
+		}
+
+		i := strings.LastIndex(item, "=")
+		if i < 0 {
+			return nil, fmt.Errorf("invalid route timeout %q, expected route=timeout", item)
+		}
+
+		routes, err := auth.ParseRoutes(item[:i])
+		if err != nil {
+			return nil, err
+		}
+
+		if len(routes) != 1 {
+			return nil, fmt.Errorf("invalid route timeout %q, expected route=timeout", item)
+		}
+
+		d, err := time.ParseDuration(strings.TrimSpace(item[i+1:]))
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("invalid timeout in route timeout %q, expected a positive duration like 2s", item)
+		}
+
+		timeouts = append(timeouts, RouteTimeout{Route: routes[0], Timeout: d})
+	}
+
+	return timeouts, nil
+}
+
+// RouteTimeouts sets the deadline of the backend calls of the routes with a timeout, in place of the default one.
+type RouteTimeouts struct {
+	timeouts []RouteTimeout
+}
+
+// NewRouteTimeouts instantiates and returns a new RouteTimeouts. The first timeout matching a route applies.
+func NewRouteTimeouts(timeouts []RouteTimeout) *RouteTimeouts {
+	return &RouteTimeouts{timeouts: timeouts}
+}
+
+// Middleware must come before the handlers calling the backends. The routes timing out get a 504.
+func (t *RouteTimeouts) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, timeout := range t.timeouts {
+			if timeout.Route.Matches(r.Method, r.URL.Path) {
+				ctx, cancel := context.WithTimeout(r.Context(), timeout.Timeout)
+				defer cancel()
+
+				r = r.WithContext(ctx)
+
+				break
+			}
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
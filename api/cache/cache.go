@@ -0,0 +1,394 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"git.neds.sh/matty/entain/api/apikeys"
+	"git.neds.sh/matty/entain/api/auth"
+	"git.neds.sh/matty/entain/api/backend"
+	"git.neds.sh/matty/entain/api/logging"
+	"git.neds.sh/matty/entain/api/proto/racing"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	log "github.com/sirupsen/logrus"
+)
+
+// maxRequestBody is the biggest request body that's part of a cache key. Requests with a bigger body aren't cached.
+const maxRequestBody = 64 << 10
+
+// Rule caches the responses of a route for a TTL.
+type Rule struct {
+	Route auth.Route
+	TTL   time.Duration
+}
+
+// ParseRules parses a comma separated list of routes and their TTL like "POST /v1/list-races=10s,GET /v1/races/*=30s".
+// The routes are the same as the public routes of the authentication, see auth.ParseRoutes.
+func ParseRules(value string) ([]Rule, error) {
+	var rules []Rule
+
+	for _, item := range strings.Split(value, ",") {
+		if strings.TrimSpace(item) == "" {
+			continue
+		}
+
+		i := strings.LastIndex(item, "=")
+		if i < 0 {
+			return nil, fmt.Errorf("invalid cache rule %q, expected route=ttl", item)
+		}
+
+		routes, err := auth.ParseRoutes(item[:i])
+		if err != nil {
+			return nil, err
+		}
+
+		if len(routes) != 1 {
+			return nil, fmt.Errorf("invalid cache rule %q, expected route=ttl", item)
+		}
+
+		ttl, err := time.ParseDuration(strings.TrimSpace(item[i+1:]))
+		if err != nil || ttl <= 0 {
+			return nil, fmt.Errorf("invalid ttl in cache rule %q, expected a positive duration like 30s", item)
+		}
+
+		rules = append(rules, Rule{Route: routes[0], TTL: ttl})
+	}
+
+	return rules, nil
+}
+
+// Cache is an HTTP middleware caching the successful responses of the routes of its rules in memory.
+//
+// The responses get a strong ETag and a Cache-Control header, and requests with a matching If-None-Match get a 304.
+// An entry expires after the TTL of its route, or earlier when a race in it starts, as that changes the race's status.
+// It's also removed when a race in it changes otherwise, see Watch.
+type Cache struct {
+	rules   []Rule
+	entries *lru
+	now     func() time.Time
+}
+
+// NewCache instantiates and returns a new Cache using at most maxBytes of memory for the responses.
+func NewCache(rules []Rule, maxBytes int) *Cache {
+	return &Cache{rules: rules, entries: newLRU(maxBytes), now: time.Now}
+}
+
+// Middleware serves the cached responses. It must come after the authentication so that only authenticated requests are served from the cache.
+func (c *Cache) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ttl, ok := c.ttl(r)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		key, ok := requestKey(r)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		now := c.now()
+
+		if e := c.entries.get(key, now); e != nil {
+			w.Header().Set("X-Cache", "HIT")
+			w.Header().Set("Age", strconv.Itoa(int(now.Sub(e.stored).Seconds())))
+			c.write(w, r, e, now)
+			return
+		}
+
+		invalidations := c.entries.invalidations()
+
+		recorder := &recorder{header: http.Header{}, status: http.StatusOK}
+		next.ServeHTTP(recorder, r)
+
+		if recorder.status != http.StatusOK {
+			recorder.copyTo(w)
+			return
+		}
+
+		body := recorder.body.Bytes()
+
+		var value interface{}
+		if err := json.Unmarshal(body, &value); err != nil {
+			value = nil
+		}
+
+		e := &entry{
+			key:     key,
+			status:  recorder.status,
+			header:  storedHeader(recorder.header),
+			body:    body,
+			etag:    etag(body),
+			stored:  now,
+			expires: expiry(value, now, ttl),
+			races:   raceIds(value),
+		}
+		c.entries.add(e, invalidations)
+
+		w.Header().Set("X-Cache", "MISS")
+		c.write(w, r, e, now)
+	})
+}
+
+// Watch removes the entries with a race in them whenever the race changes, e.g. its status, until the context is done.
+// The updates come from the watch stream of the racing service.
+func (c *Cache) Watch(ctx context.Context, client racing.RacingClient) {
+	backend.Stream(ctx, "cache invalidation", func(connected func()) error {
+		stream, err := client.WatchRaces(ctx, &racing.WatchRacesRequest{})
+		if err != nil {
+			return err
+		}
+
+		for {
+			update, err := stream.Recv()
+			if err != nil {
+				return err
+			}
+
+			connected()
+
+			c.entries.removeRace(update.RaceId)
+		}
+	})
+}
+
+// ttl returns the TTL of the first rule matching the request.
+func (c *Cache) ttl(r *http.Request) (time.Duration, bool) {
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		return 0, false
+	}
+
+	for _, rule := range c.rules {
+		if rule.Route.Matches(r.Method, r.URL.Path) {
+			return rule.TTL, true
+		}
+	}
+
+	return 0, false
+}
+
+// write writes a cached response, or a 304 when the client already has it.
+func (c *Cache) write(w http.ResponseWriter, r *http.Request, e *entry, now time.Time) {
+	for name, values := range e.header {
+		w.Header()[name] = values
+	}
+
+	// Responses to authenticated requests mustn't be served to someone else by a shared cache.
+	visibility := "public"
+	if r.Header.Get("Authorization") != "" || r.Header.Get(apikeys.Header) != "" {
+		visibility = "private"
+	}
+
+	w.Header().Set("ETag", e.etag)
+	w.Header().Set("Cache-Control", fmt.Sprintf("%s, max-age=%d", visibility, int(e.expires.Sub(now).Seconds())))
+
+	if matchesETag(r.Header.Get("If-None-Match"), e.etag) {
+		w.Header().Del("Content-Length")
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.WriteHeader(e.status)
+	if _, err := w.Write(e.body); err != nil {
+		log.Debugf("failed writing cached response: %s", err)
+	}
+}
+
+// requestKey identifies a request by its method, path, query and body. The query parameters are sorted and the JSON body
+// is re-encoded with sorted keys and without whitespace, so that equivalent requests share an entry.
+func requestKey(r *http.Request) (string, bool) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestBody+1))
+	if err != nil {
+		return "", false
+	}
+
+	// The request still goes to the next handler on a miss.
+	r.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), r.Body))
+
+	if len(body) > maxRequestBody {
+		return "", false
+	}
+
+	return r.Method + " " + r.URL.Path + "?" + r.URL.Query().Encode() + "\n" + string(normaliseJSON(body)), true
+}
+
+func normaliseJSON(body []byte) []byte {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return body
+	}
+
+	normalised, err := json.Marshal(value)
+	if err != nil {
+		return body
+	}
+
+	return normalised
+}
+
+// etag is a strong ETag, i.e. a hash of the response body.
+func etag(body []byte) string {
+	sum := sha256.Sum256(body)
+
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// matchesETag implements the weak comparison of If-None-Match.
+func matchesETag(ifNoneMatch string, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// storedHeader returns the headers of a response that can be served to other requests. The request id and the metadata
+// the backends sent back belong to the request that was cached.
+func storedHeader(header http.Header) http.Header {
+	stored := http.Header{}
+
+	for name, values := range header {
+		if name == http.CanonicalHeaderKey(logging.Header) || strings.HasPrefix(name, runtime.MetadataHeaderPrefix) {
+			continue
+		}
+
+		stored[name] = values
+	}
+
+	return stored
+}
+
+// expiry returns when the decoded response expires: after the TTL, or when the first race in it that hasn't started yet
+// starts.
+func expiry(value interface{}, now time.Time, ttl time.Duration) time.Time {
+	expires := now.Add(ttl)
+
+	walk(value, func(key string, value interface{}) {
+		if key != "advertisedStartTime" {
+			return
+		}
+
+		s, ok := value.(string)
+		if !ok {
+			return
+		}
+
+		start, err := time.Parse(time.RFC3339Nano, s)
+		if err == nil && start.After(now) && start.Before(expires) {
+			expires = start
+		}
+	})
+
+	return expires
+}
+
+// raceIds returns the ids of the races in a decoded response: the races themselves and the races of the outrights.
+func raceIds(value interface{}) map[int64]bool {
+	ids := map[int64]bool{}
+
+	add := func(value interface{}) {
+		if race, ok := value.(map[string]interface{}); ok {
+			if id, ok := toId(race["id"]); ok {
+				ids[id] = true
+			}
+		}
+	}
+
+	walk(value, func(key string, value interface{}) {
+		switch key {
+		case "race":
+			add(value)
+		case "races":
+			if races, ok := value.([]interface{}); ok {
+				for _, race := range races {
+					add(race)
+				}
+			}
+		case "raceId":
+			if id, ok := toId(value); ok {
+				ids[id] = true
+			}
+		}
+	})
+
+	return ids
+}
+
+// toId reads an id encoded as a JSON string, like the REST routes encode the int64s, or as a JSON number.
+func toId(value interface{}) (int64, bool) {
+	switch value := value.(type) {
+	case string:
+		id, err := strconv.ParseInt(value, 10, 64)
+		return id, err == nil
+	case float64:
+		return int64(value), true
+	default:
+		return 0, false
+	}
+}
+
+// walk calls fn with every key and value of the objects in a decoded JSON value.
+func walk(value interface{}, fn func(key string, value interface{})) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for k, v := range value {
+			fn(k, v)
+			walk(v, fn)
+		}
+	case []interface{}:
+		for _, v := range value {
+			walk(v, fn)
+		}
+	}
+}
+
+// recorder buffers the response of the next handler so that it can be cached.
+type recorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (r *recorder) Header() http.Header {
+	return r.header
+}
+
+func (r *recorder) WriteHeader(status int) {
+	r.status = status
+}
+
+func (r *recorder) Write(b []byte) (int, error) {
+	return r.body.Write(b)
+}
+
+// copyTo writes the buffered response as is.
+func (r *recorder) copyTo(w http.ResponseWriter) {
+	for name, values := range r.header {
+		w.Header()[name] = values
+	}
+
+	w.WriteHeader(r.status)
+	if _, err := w.Write(r.body.Bytes()); err != nil {
+		log.Debugf("failed writing response: %s", err)
+	}
+}
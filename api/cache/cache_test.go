@@ -0,0 +1,316 @@
+package cache
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"git.neds.sh/matty/entain/api/auth"
+)
+
+func TestRequestKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		a, b   *http.Request
+		wantEq bool
+	}{
+		{
+			name:   "query order",
+			a:      httptest.NewRequest("GET", "/v1/races?b=2&a=1", nil),
+			b:      httptest.NewRequest("GET", "/v1/races?a=1&b=2", nil),
+			wantEq: true,
+		},
+		{
+			name:   "JSON whitespace and key order",
+			a:      httptest.NewRequest("POST", "/v1/list-races", strings.NewReader(`{"filter":{"meetingIds":[1,2],"visible":true}}`)),
+			b:      httptest.NewRequest("POST", "/v1/list-races", strings.NewReader(`{ "filter": { "visible": true, "meetingIds": [1, 2] } }`)),
+			wantEq: true,
+		},
+		{
+			name:   "big ids",
+			a:      httptest.NewRequest("POST", "/v1/list-races", strings.NewReader(`{"id":9007199254740993}`)),
+			b:      httptest.NewRequest("POST", "/v1/list-races", strings.NewReader(`{"id":9007199254740992}`)),
+			wantEq: false,
+		},
+		{
+			name:   "different bodies",
+			a:      httptest.NewRequest("POST", "/v1/list-races", strings.NewReader(`{"filter":{"meetingIds":[1]}}`)),
+			b:      httptest.NewRequest("POST", "/v1/list-races", strings.NewReader(`{"filter":{"meetingIds":[2]}}`)),
+			wantEq: false,
+		},
+		{
+			name:   "different methods",
+			a:      httptest.NewRequest("GET", "/v1/races", nil),
+			b:      httptest.NewRequest("POST", "/v1/races", nil),
+			wantEq: false,
+		},
+		{
+			name:   "different paths",
+			a:      httptest.NewRequest("GET", "/v1/races/1", nil),
+			b:      httptest.NewRequest("GET", "/v1/races/2", nil),
+			wantEq: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, ok := requestKey(tt.a)
+			if !ok {
+				t.Fatal("requestKey(a) isn't cacheable")
+			}
+
+			b, ok := requestKey(tt.b)
+			if !ok {
+				t.Fatal("requestKey(b) isn't cacheable")
+			}
+
+			if (a == b) != tt.wantEq {
+				t.Errorf("requestKey(a) = %q, requestKey(b) = %q, want equal %v", a, b, tt.wantEq)
+			}
+		})
+	}
+}
+
+func TestRequestKeyKeepsTheBody(t *testing.T) {
+	body := `{"filter":{}}`
+	r := httptest.NewRequest("POST", "/v1/list-races", strings.NewReader(body))
+
+	if _, ok := requestKey(r); !ok {
+		t.Fatal("requestKey() isn't cacheable")
+	}
+
+	got, err := io.ReadAll(r.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(got) != body {
+		t.Errorf("the body read after requestKey() is %q, want %q", got, body)
+	}
+}
+
+func TestRequestKeyBodyTooBig(t *testing.T) {
+	r := httptest.NewRequest("POST", "/v1/list-races", strings.NewReader(strings.Repeat(" ", maxRequestBody+1)))
+
+	if _, ok := requestKey(r); ok {
+		t.Error("requestKey() of a body bigger than maxRequestBody is cacheable")
+	}
+}
+
+func TestExpiry(t *testing.T) {
+	now := time.Date(2021, 10, 2, 10, 0, 0, 0, time.UTC)
+	ttl := time.Minute
+
+	tests := []struct {
+		name string
+		body string
+		want time.Time
+	}{
+		{name: "no races", body: `{"races":[]}`, want: now.Add(ttl)},
+		{name: "not JSON", body: `not JSON`, want: now.Add(ttl)},
+		{
+			name: "race starting within the TTL",
+			body: `{"races":[{"id":"1","advertisedStartTime":"2021-10-02T10:00:30Z"},{"id":"2","advertisedStartTime":"2021-10-02T10:00:20Z"}]}`,
+			want: now.Add(20 * time.Second),
+		},
+		{
+			name: "race starting after the TTL",
+			body: `{"race":{"id":"1","advertisedStartTime":"2021-10-02T11:00:00Z"}}`,
+			want: now.Add(ttl),
+		},
+		{
+			name: "race already started",
+			body: `{"race":{"id":"1","advertisedStartTime":"2021-10-02T09:00:00Z"}}`,
+			want: now.Add(ttl),
+		},
+		{
+			name: "nested race",
+			body: `{"data":{"meetings":[{"races":[{"advertisedStartTime":"2021-10-02T10:00:10Z"}]}]}}`,
+			want: now.Add(10 * time.Second),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := expiry(decode(tt.body), now, ttl); !got.Equal(tt.want) {
+				t.Errorf("expiry() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRaceIds(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want map[int64]bool
+	}{
+		{name: "race", body: `{"race":{"id":"7","name":"Race 7"}}`, want: map[int64]bool{7: true}},
+		{name: "races", body: `{"races":[{"id":"1"},{"id":"2"}]}`, want: map[int64]bool{1: true, 2: true}},
+		{name: "outrights", body: `{"outrights":[{"id":"3","raceId":"4","race":"Race 4"}]}`, want: map[int64]bool{4: true}},
+		{name: "GraphQL numbers", body: `{"data":{"races":[{"id":5}]}}`, want: map[int64]bool{5: true}},
+		{name: "sports", body: `{"sports":[{"id":"1","meetingId":"2"}]}`, want: map[int64]bool{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := raceIds(decode(tt.body)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("raceIds() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStoredHeader(t *testing.T) {
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+	header.Set("X-Request-ID", "abc")
+	header.Set("Grpc-Metadata-Content-Type", "application/grpc")
+	header.Set("Grpc-Metadata-Auth-Subject", "alice")
+
+	want := http.Header{"Content-Type": {"application/json"}}
+
+	if got := storedHeader(header); !reflect.DeepEqual(got, want) {
+		t.Errorf("storedHeader() = %v, want %v", got, want)
+	}
+}
+
+func TestLRU(t *testing.T) {
+	now := time.Date(2021, 10, 2, 10, 0, 0, 0, time.UTC)
+
+	newEntry := func(key string, expires time.Duration, races ...int64) *entry {
+		e := &entry{key: key, body: make([]byte, 100), expires: now.Add(expires), races: map[int64]bool{}}
+		for _, race := range races {
+			e.races[race] = true
+		}
+
+		return e
+	}
+
+	tests := []struct {
+		name string
+		run  func(c *lru)
+		get  time.Duration
+		want []string
+		gone []string
+	}{
+		{
+			name: "expiry",
+			run: func(c *lru) {
+				c.add(newEntry("a", time.Minute), 0)
+				c.add(newEntry("b", time.Hour), 0)
+			},
+			get:  time.Minute,
+			want: []string{"b"},
+			gone: []string{"a"},
+		},
+		{
+			name: "least recently used evicted first",
+			run: func(c *lru) {
+				c.add(newEntry("a", time.Hour), 0)
+				c.add(newEntry("b", time.Hour), 0)
+				c.get("a", now)
+				c.add(newEntry("c", time.Hour), 0)
+			},
+			want: []string{"a", "c"},
+			gone: []string{"b"},
+		},
+		{
+			name: "race removed",
+			run: func(c *lru) {
+				c.add(newEntry("a", time.Hour, 1, 2), 0)
+				c.add(newEntry("b", time.Hour, 3), 0)
+				c.removeRace(2)
+			},
+			want: []string{"b"},
+			gone: []string{"a"},
+		},
+		{
+			name: "entry started before a race was removed",
+			run: func(c *lru) {
+				invalidations := c.invalidations()
+				c.removeRace(1)
+				c.add(newEntry("a", time.Hour, 1), invalidations)
+				c.add(newEntry("b", time.Hour, 1), c.invalidations())
+			},
+			want: []string{"b"},
+			gone: []string{"a"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Room for two entries.
+			c := newLRU(2 * newEntry("a", 0).size())
+			tt.run(c)
+
+			for _, key := range tt.want {
+				if c.get(key, now.Add(tt.get)) == nil {
+					t.Errorf("get(%q) = nil, want the entry", key)
+				}
+			}
+
+			for _, key := range tt.gone {
+				if c.get(key, now.Add(tt.get)) != nil {
+					t.Errorf("get(%q) returned an entry, want nil", key)
+				}
+			}
+		})
+	}
+}
+
+func TestParseRules(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    []Rule
+		wantErr bool
+	}{
+		{value: "", want: nil},
+		{
+			value: "POST /v1/list-races=10s, GET /v1/races/*=30s",
+			want: []Rule{
+				{Route: mustRoute(t, "POST /v1/list-races"), TTL: 10 * time.Second},
+				{Route: mustRoute(t, "GET /v1/races/*"), TTL: 30 * time.Second},
+			},
+		},
+		{value: "GET /v1/races", wantErr: true},
+		{value: "GET /v1/races=0s", wantErr: true},
+		{value: "GET /v1/races=soon", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseRules(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseRules(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseRules(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func mustRoute(t *testing.T, value string) auth.Route {
+	routes, err := auth.ParseRoutes(value)
+	if err != nil || len(routes) != 1 {
+		t.Fatalf("auth.ParseRoutes(%q) = %v, %v", value, routes, err)
+	}
+
+	return routes[0]
+}
+
+func decode(body string) interface{} {
+	var value interface{}
+	if err := json.Unmarshal([]byte(body), &value); err != nil {
+		return nil
+	}
+
+	return value
+}
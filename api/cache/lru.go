@@ -0,0 +1,135 @@
+// Package cache caches the gateway's responses to read only routes.
+package cache
+
+import (
+	"container/list"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// entry is a cached response.
+type entry struct {
+	key     string
+	status  int
+	header  http.Header
+	body    []byte
+	etag    string
+	stored  time.Time
+	expires time.Time
+	// races are the ids of the races in the response, see Cache.Watch.
+	races map[int64]bool
+}
+
+// size is an estimate of the memory used by the entry.
+func (e *entry) size() int {
+	size := len(e.key) + len(e.body) + len(e.etag)
+
+	for name, values := range e.header {
+		size += len(name)
+		for _, value := range values {
+			size += len(value)
+		}
+	}
+
+	return size
+}
+
+// lru is a cache of responses bounded by the memory they use. The least recently used entries are evicted first.
+type lru struct {
+	maxBytes int
+
+	mu      sync.Mutex
+	bytes   int
+	order   *list.List
+	entries map[string]*list.Element
+	// removedRaces counts the calls to removeRace, so that a response started before one isn't stored after it.
+	removedRaces uint64
+}
+
+// newLRU instantiates and returns a new lru using at most maxBytes.
+func newLRU(maxBytes int) *lru {
+	return &lru{maxBytes: maxBytes, order: list.New(), entries: map[string]*list.Element{}}
+}
+
+// get returns the entry with the given key if it hasn't expired yet.
+func (c *lru) get(key string, now time.Time) *entry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return nil
+	}
+
+	e := element.Value.(*entry)
+	if !now.Before(e.expires) {
+		c.remove(element)
+		return nil
+	}
+
+	c.order.MoveToFront(element)
+
+	return e
+}
+
+// add stores the entry, evicting the least recently used entries to make room for it.
+// Entries bigger than the whole cache aren't stored, nor entries that may be stale, i.e. when a race was removed since
+// the given count of invalidations was read.
+func (c *lru) add(e *entry, invalidations uint64) {
+	size := e.size()
+	if size > c.maxBytes {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.removedRaces != invalidations {
+		return
+	}
+
+	if element, ok := c.entries[e.key]; ok {
+		c.remove(element)
+	}
+
+	for c.bytes+size > c.maxBytes {
+		c.remove(c.order.Back())
+	}
+
+	c.entries[e.key] = c.order.PushFront(e)
+	c.bytes += size
+}
+
+// invalidations returns the count of invalidations, to give to add.
+func (c *lru) invalidations() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.removedRaces
+}
+
+// removeRace removes the entries with the given race in them.
+func (c *lru) removeRace(raceId int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.removedRaces++
+
+	for element := c.order.Front(); element != nil; {
+		next := element.Next()
+
+		if element.Value.(*entry).races[raceId] {
+			c.remove(element)
+		}
+
+		element = next
+	}
+}
+
+// remove removes an element. It must be called with the lock held.
+func (c *lru) remove(element *list.Element) {
+	e := c.order.Remove(element).(*entry)
+	delete(c.entries, e.key)
+	c.bytes -= e.size()
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"git.neds.sh/matty/entain/common/tlsconfig"
+)
+
+// genCerts implements the gen-certs sub command, which writes a development CA along with a server certificate, for
+// the gateway and the services, and a client certificate, for the gateway calling the services with mutual TLS.
+//
+//	api gen-certs [-dir certs] [-hosts localhost,127.0.0.1] [-validity 8760h]
+//
+// Running it again renews the certificates with the same CA. The servers pick up the new ones within a second, there's no
+// need to restart them.
+func genCerts(args []string) error {
+	fs := flag.NewFlagSet("gen-certs", flag.ExitOnError)
+	dir := fs.String("dir", "certs", "Directory the CA, the certificates and their keys are written to")
+	hosts := fs.String("hosts", "localhost,127.0.0.1", "Comma separated names and IP addresses of the server certificate")
+	validity := fs.Duration("validity", 365*24*time.Hour, "How long the server and client certificates are valid")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s gen-certs [-dir dir] [-hosts hosts] [-validity duration]\n", filepath.Base(os.Args[0]))
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() > 0 {
+		fs.Usage()
+		return fmt.Errorf("unexpected arguments %q", strings.Join(fs.Args(), " "))
+	}
+
+	if *validity <= 0 {
+		return errors.New("the validity must be positive")
+	}
+
+	var names []string
+	for _, host := range strings.Split(*hosts, ",") {
+		if host = strings.TrimSpace(host); host != "" {
+			names = append(names, host)
+		}
+	}
+
+	files, err := tlsconfig.Generate(*dir, names, *validity)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		fmt.Printf("Wrote %s\n", file)
+	}
+
+	path := func(file string) string {
+		return filepath.Join(*dir, file)
+	}
+
+	fmt.Printf("\nServe racing and sports with mutual TLS:\n  -tls-cert %s -tls-key %s -tls-client-ca %s\n",
+		path(tlsconfig.ServerFile), path(tlsconfig.ServerKeyFile), path(tlsconfig.CAFile))
+	fmt.Printf("Call them from the gateway:\n  -backend-tls-ca %s -backend-tls-cert %s -backend-tls-key %s\n",
+		path(tlsconfig.CAFile), path(tlsconfig.ClientFile), path(tlsconfig.ClientKeyFile))
+
+	return nil
+}
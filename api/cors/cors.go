@@ -0,0 +1,128 @@
+// Package cors lets the browser apps of other origins call the gateway, e.g. with the gRPC-Web and Connect clients.
+package cors
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// maxAge is how long browsers can cache the answer to a preflight request.
+const maxAge = 2 * 60 * 60
+
+// allowedMethods are the methods of the routes of the gateway, e.g. PUT to record the result of a sports event.
+var allowedMethods = []string{
+	http.MethodGet,
+	http.MethodPost,
+	http.MethodPut,
+	http.MethodOptions,
+}
+
+// allowedHeaders are the request headers browsers may send: the authentication, the request id and the headers of the
+// gRPC-Web and Connect protocols.
+var allowedHeaders = []string{
+	"Authorization",
+	"Content-Type",
+	"X-API-Key",
+	"X-Request-ID",
+	"X-Grpc-Web",
+	"X-User-Agent",
+	"Grpc-Timeout",
+	"Connect-Protocol-Version",
+	"Connect-Timeout-Ms",
+}
+
+// exposedHeaders are the response headers browsers let the apps read. Connect sends the trailers of unary calls as
+// Trailer- prefixed headers, which can only be matched with the wildcard.
+var exposedHeaders = []string{
+	"*",
+	"X-Request-ID",
+	"X-RateLimit-Limit",
+	"X-RateLimit-Remaining",
+	"X-RateLimit-Reset",
+	"Retry-After",
+	"Grpc-Status",
+	"Grpc-Message",
+	"Grpc-Status-Details-Bin",
+}
+
+// CORS answers the preflight requests and adds the CORS headers to the responses of the allowed origins.
+type CORS struct {
+	origins map[string]bool
+	any     bool
+}
+
+// ParseOrigins parses a comma separated list of origins, e.g. https://tools.example.com. * allows any origin.
+func ParseOrigins(value string) ([]string, error) {
+	var origins []string
+
+	for _, origin := range strings.Split(value, ",") {
+		origin = strings.TrimSpace(origin)
+		if origin == "" {
+			continue
+		}
+
+		if origin != "*" {
+			u, err := url.Parse(origin)
+			if err != nil || u.Scheme == "" || u.Host == "" || u.Path != "" {
+				return nil, fmt.Errorf("invalid origin %q, expected a scheme and a host, e.g. https://tools.example.com", origin)
+			}
+		}
+
+		origins = append(origins, origin)
+	}
+
+	return origins, nil
+}
+
+// NewCORS instantiates and returns a new CORS allowing the given origins.
+func NewCORS(origins []string) *CORS {
+	c := &CORS{origins: map[string]bool{}}
+
+	for _, origin := range origins {
+		if origin == "*" {
+			c.any = true
+		}
+
+		c.origins[strings.ToLower(origin)] = true
+	}
+
+	return c
+}
+
+// Middleware must come before the authentication, browsers don't send credentials with the preflight requests.
+func (c *CORS) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Add("Vary", "Origin")
+
+		if !c.any && !c.origins[strings.ToLower(origin)] {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+
+		if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+			w.Header().Add("Vary", "Access-Control-Request-Method")
+			w.Header().Add("Vary", "Access-Control-Request-Headers")
+			w.Header().Set("Access-Control-Allow-Methods", strings.Join(allowedMethods, ", "))
+			w.Header().Set("Access-Control-Allow-Headers", strings.Join(allowedHeaders, ", "))
+			w.Header().Set("Access-Control-Max-Age", strconv.Itoa(maxAge))
+			w.WriteHeader(http.StatusNoContent)
+
+			return
+		}
+
+		w.Header().Set("Access-Control-Expose-Headers", strings.Join(exposedHeaders, ", "))
+
+		next.ServeHTTP(w, r)
+	})
+}
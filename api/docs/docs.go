@@ -0,0 +1,74 @@
+// Package docs serves the OpenAPI spec of the gateway and a Swagger UI to browse it.
+//
+// The spec is generated from the protos in api/proto along with the gateway code, see api/proto/api.go.
+package docs
+
+import (
+	_ "embed"
+	"encoding/json"
+	"net/http"
+	"sort"
+
+	swaggerFiles "github.com/swaggo/files"
+)
+
+//go:embed api.swagger.json
+var spec []byte
+
+//go:embed index.html
+var index []byte
+
+// Register adds the routes of the spec (/openapi.json) and of the Swagger UI (/docs) to the mux.
+func Register(mux *http.ServeMux) {
+	mux.HandleFunc("/openapi.json", serve("application/json", spec))
+	mux.Handle("/docs", http.RedirectHandler("/docs/", http.StatusMovedPermanently))
+	mux.Handle("/docs/", ui())
+}
+
+// Paths returns the path templates of the API routes in the spec, e.g. /v1/races/{id}.
+func Paths() ([]string, error) {
+	var doc struct {
+		Paths map[string]json.RawMessage `json:"paths"`
+	}
+
+	if err := json.Unmarshal(spec, &doc); err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	for path := range doc.Paths {
+		paths = append(paths, path)
+	}
+
+	sort.Strings(paths)
+
+	return paths, nil
+}
+
+// ui serves our index.html, which points the Swagger UI at our spec, and the Swagger UI assets.
+func ui() http.Handler {
+	assets := http.StripPrefix("/docs", http.FileServer(swaggerFiles.HTTP))
+	page := serve("text/html; charset=utf-8", index)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/docs/" || r.URL.Path == "/docs/index.html" {
+			page(w, r)
+			return
+		}
+
+		assets.ServeHTTP(w, r)
+	})
+}
+
+func serve(contentType string, content []byte) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", contentType)
+		w.Write(content)
+	}
+}
@@ -0,0 +1,234 @@
+// Package feed serves the upcoming feed of the gateway, which merges the next races and sports events of the backends.
+package feed
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"git.neds.sh/matty/entain/api/proto/racing"
+	"git.neds.sh/matty/entain/api/proto/sports"
+	"git.neds.sh/matty/entain/api/proto/upcoming"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultLimit = 10
+	maxLimit     = 100
+)
+
+// Service implements the Upcoming service on top of the racing and sports services.
+type Service struct {
+	upcoming.UnimplementedUpcomingServer
+
+	racing  racing.RacingClient
+	sports  sports.SportsClient
+	timeout time.Duration
+	now     func() time.Time
+}
+
+// NewService instantiates and returns a new Service. A backend that doesn't answer within the timeout is left out of the feed.
+func NewService(racingClient racing.RacingClient, sportsClient sports.SportsClient, timeout time.Duration) *Service {
+	return &Service{racing: racingClient, sports: sportsClient, timeout: timeout, now: time.Now}
+}
+
+// result is what a backend returned for its category.
+type result struct {
+	category upcoming.Category
+	items    []*upcoming.UpcomingItem
+	err      error
+}
+
+// ListUpcoming fans out to the backends of the requested categories at once and merges their races and sports events
+// by advertised start time. The feed is partial when some of the backends fail, and an error when all of them do.
+func (s *Service) ListUpcoming(ctx context.Context, in *upcoming.ListUpcomingRequest) (*upcoming.ListUpcomingResponse, error) {
+	limit := int(in.Limit)
+	if limit < 0 || limit > maxLimit {
+		message := fmt.Sprintf("limit must be between 1 and %d", maxLimit)
+
+		st, err := status.New(codes.InvalidArgument, message).WithDetails(&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: "limit", Description: message}},
+		})
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, message)
+		}
+
+		return nil, st.Err()
+	}
+
+	if limit == 0 {
+		limit = defaultLimit
+	}
+
+	categories := categories(in.Categories)
+
+	// The backends get the metadata of the request, e.g. its request id and caller, like the requests proxied by the gateway.
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		ctx = metadata.NewOutgoingContext(ctx, md)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	now := s.now()
+	results := make([]result, len(categories))
+
+	var wg sync.WaitGroup
+
+	for i, category := range categories {
+		wg.Add(1)
+
+		go func(i int, category upcoming.Category) {
+			defer wg.Done()
+
+			results[i].category = category
+
+			switch category {
+			case upcoming.Category_RACING:
+				results[i].items, results[i].err = s.races(ctx, in.Country, now, limit)
+			case upcoming.Category_SPORTS:
+				results[i].items, results[i].err = s.sportsEvents(ctx, in.Country, now, limit)
+			}
+		}(i, category)
+	}
+
+	wg.Wait()
+
+	response := &upcoming.ListUpcomingResponse{}
+
+	for _, result := range results {
+		if result.err != nil {
+			log.Warnf("leaving %s out of the upcoming feed: %s", result.category, result.err)
+
+			response.Unavailable = append(response.Unavailable, &upcoming.UnavailableCategory{
+				Category: result.category,
+				Error:    status.Convert(result.err).Message(),
+			})
+
+			continue
+		}
+
+		response.Items = append(response.Items, result.items...)
+	}
+
+	if len(response.Unavailable) == len(categories) {
+		return nil, status.Error(codes.Unavailable, "none of the backends of the upcoming feed could be reached")
+	}
+
+	response.Partial = len(response.Unavailable) > 0
+
+	// Races come before sports events starting at the same time so that the order is stable.
+	sort.SliceStable(response.Items, func(i, j int) bool {
+		a, b := response.Items[i].AdvertisedStartTime.AsTime(), response.Items[j].AdvertisedStartTime.AsTime()
+		if a.Equal(b) {
+			return response.Items[i].Category < response.Items[j].Category
+		}
+
+		return a.Before(b)
+	})
+
+	if len(response.Items) > limit {
+		response.Items = response.Items[:limit]
+	}
+
+	return response, nil
+}
+
+// races returns the next visible races, at most limit of them.
+func (s *Service) races(ctx context.Context, country string, now time.Time, limit int) ([]*upcoming.UpcomingItem, error) {
+	visible := true
+
+	response, err := s.racing.ListRaces(ctx, &racing.ListRacesRequest{
+		Filter: &racing.ListRacesRequestFilter{MeetingVisibility: &visible, Country: country},
+		OrderBy: &racing.ListRacesRequestOrderBy{OrderByFields: []*racing.OrderByField{
+			{Field: "advertised_start_time", Direction: racing.OrderByField_ASC},
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*upcoming.UpcomingItem
+
+	for _, race := range response.Races {
+		if len(items) == limit {
+			break
+		}
+
+		if !race.AdvertisedStartTime.AsTime().After(now) {
+			continue
+		}
+
+		items = append(items, &upcoming.UpcomingItem{
+			Category:            upcoming.Category_RACING,
+			Id:                  race.Id,
+			Name:                race.Name,
+			AdvertisedStartTime: race.AdvertisedStartTime,
+			Event:               &upcoming.UpcomingItem_Race{Race: race},
+		})
+	}
+
+	return items, nil
+}
+
+// sportsEvents returns the next visible sports events, at most limit of them.
+func (s *Service) sportsEvents(ctx context.Context, country string, now time.Time, limit int) ([]*upcoming.UpcomingItem, error) {
+	visible := true
+
+	response, err := s.sports.ListEvents(ctx, &sports.ListEventsRequest{
+		Filter: &sports.ListEventsRequestFilter{MeetingVisibility: &visible, Country: country},
+		OrderBy: &sports.ListEventsRequestOrderBy{OrderByFields: []*sports.OrderByField{
+			{Field: "advertised_start_time", Direction: sports.OrderByField_ASC},
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*upcoming.UpcomingItem
+
+	for _, sport := range response.Sports {
+		if len(items) == limit {
+			break
+		}
+
+		if !sport.AdvertisedStartTime.AsTime().After(now) {
+			continue
+		}
+
+		items = append(items, &upcoming.UpcomingItem{
+			Category:            upcoming.Category_SPORTS,
+			Id:                  sport.Id,
+			Name:                sport.Name,
+			AdvertisedStartTime: sport.AdvertisedStartTime,
+			Event:               &upcoming.UpcomingItem_Sport{Sport: sport},
+		})
+	}
+
+	return items, nil
+}
+
+// categories returns the requested categories without duplicates, or all of them when none are requested.
+func categories(requested []upcoming.Category) []upcoming.Category {
+	if len(requested) == 0 {
+		return []upcoming.Category{upcoming.Category_RACING, upcoming.Category_SPORTS}
+	}
+
+	var categories []upcoming.Category
+	seen := map[upcoming.Category]bool{}
+
+	for _, category := range requested {
+		if !seen[category] {
+			categories = append(categories, category)
+			seen[category] = true
+		}
+	}
+
+	return categories
+}
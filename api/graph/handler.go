@@ -0,0 +1,144 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"git.neds.sh/matty/entain/api/logging"
+	"git.neds.sh/matty/entain/api/proto/racing"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// maxRequestBody is the biggest query accepted.
+const maxRequestBody = 64 << 10
+
+// request is a GraphQL request, as sent in the body of a POST or in the query of a GET.
+type request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Handler serves the GraphQL endpoint.
+type Handler struct {
+	schema     graphql.Schema
+	racing     racing.RacingClient
+	limits     Limits
+	annotators []func(context.Context, *http.Request) metadata.MD
+}
+
+// NewHandler instantiates and returns a new Handler. The annotators add the gRPC metadata forwarded to the backends,
+// the same as the gateway's runtime.WithMetadata ones.
+func NewHandler(schema graphql.Schema, racingClient racing.RacingClient, limits Limits, annotators ...func(context.Context, *http.Request) metadata.MD) *Handler {
+	return &Handler{schema: schema, racing: racingClient, limits: limits, annotators: annotators}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req request
+
+	switch r.Method {
+	case http.MethodGet:
+		req.Query = r.URL.Query().Get("query")
+		req.OperationName = r.URL.Query().Get("operationName")
+
+		if variables := r.URL.Query().Get("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &req.Variables); err != nil {
+				h.write(w, r, http.StatusBadRequest, errorResult("invalid variables: "+err.Error()))
+				return
+			}
+		}
+	case http.MethodPost:
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBody)).Decode(&req); err != nil {
+			h.write(w, r, http.StatusBadRequest, errorResult("invalid request body: "+err.Error()))
+			return
+		}
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		h.write(w, r, http.StatusMethodNotAllowed, errorResult("only GET and POST are allowed"))
+		return
+	}
+
+	if req.Query == "" {
+		h.write(w, r, http.StatusBadRequest, errorResult("a query is required"))
+		return
+	}
+
+	// Syntax errors are reported by the executor, the limits only apply to valid documents.
+	document, err := parser.Parse(parser.ParseParams{Source: source.NewSource(&source.Source{Body: []byte(req.Query)})})
+	if err == nil {
+		if err := h.limits.check(h.schema, document, req.OperationName, req.Variables); err != nil {
+			h.write(w, r, http.StatusBadRequest, errorResult(err.Error()))
+			return
+		}
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema:         h.schema,
+		RequestString:  req.Query,
+		VariableValues: req.Variables,
+		OperationName:  req.OperationName,
+		Context:        h.context(r),
+	})
+
+	h.write(w, r, http.StatusOK, result)
+}
+
+// context returns the context of the resolvers, with the loaders of the request and the metadata forwarded to the backends.
+func (h *Handler) context(r *http.Request) context.Context {
+	ctx := context.WithValue(r.Context(), loadersKey{}, newLoaders(h.racing))
+
+	var md metadata.MD
+	for _, annotator := range h.annotators {
+		md = metadata.Join(md, annotator(ctx, r))
+	}
+
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// write writes the result, with the request id in the extensions of its errors and the messages of the gRPC errors
+// without their code.
+func (h *Handler) write(w http.ResponseWriter, r *http.Request, code int, result *graphql.Result) {
+	id := logging.RequestID(r.Context())
+
+	for i, err := range result.Errors {
+		original := err.OriginalError()
+		if wrapped, ok := original.(*gqlerrors.Error); ok {
+			original = wrapped.OriginalError
+		}
+
+		if original != nil {
+			if st, ok := status.FromError(original); ok {
+				err.Message = st.Message()
+				err.Extensions = map[string]interface{}{"code": st.Code().String()}
+			}
+		}
+
+		if id != "" {
+			if err.Extensions == nil {
+				err.Extensions = map[string]interface{}{}
+			}
+
+			err.Extensions["requestId"] = id
+		}
+
+		result.Errors[i] = err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		log.Debugf("failed writing graphql response: %s", err)
+	}
+}
+
+func errorResult(message string) *graphql.Result {
+	return &graphql.Result{Errors: []gqlerrors.FormattedError{{Message: message}}}
+}
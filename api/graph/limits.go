@@ -0,0 +1,178 @@
+package graph
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// defaultListSize is how many items a list without a limit argument is expected to have when computing the complexity.
+const defaultListSize = 10
+
+// Limits bound the cost of the queries, so that a single query can't fan out to thousands of backend calls.
+type Limits struct {
+	// MaxDepth is how deeply the fields of a query can be nested, e.g. 3 for { meeting { races { name } } }.
+	MaxDepth int
+	// MaxComplexity is the maximum number of fields a query can resolve. A list field counts its fields once per item,
+	// i.e. limit times or 10 times without a limit argument.
+	MaxComplexity int
+}
+
+// cost is the depth and the complexity of a selection.
+type cost struct {
+	depth      int
+	complexity int
+}
+
+// check returns an error when the operation of the document exceeds the limits.
+// Invalid documents are left to the validation of the executor.
+func (l Limits) check(schema graphql.Schema, document *ast.Document, operationName string, variables map[string]interface{}) error {
+	fragments := map[string]*ast.FragmentDefinition{}
+	var operations []*ast.OperationDefinition
+
+	for _, definition := range document.Definitions {
+		switch definition := definition.(type) {
+		case *ast.FragmentDefinition:
+			fragments[definition.Name.Value] = definition
+		case *ast.OperationDefinition:
+			if operationName == "" || (definition.Name != nil && definition.Name.Value == operationName) {
+				operations = append(operations, definition)
+			}
+		}
+	}
+
+	// Queries are the only operations of the schema.
+	if len(operations) != 1 || operations[0].Operation != ast.OperationTypeQuery {
+		return nil
+	}
+
+	a := &analysis{schema: schema, fragments: fragments, variables: variables, visiting: map[string]bool{}}
+	c := a.selectionSet(schema.QueryType(), operations[0].SelectionSet)
+
+	if l.MaxDepth > 0 && c.depth > l.MaxDepth {
+		return fmt.Errorf("the query has a depth of %d, more than the maximum of %d", c.depth, l.MaxDepth)
+	}
+
+	if l.MaxComplexity > 0 && c.complexity > l.MaxComplexity {
+		return fmt.Errorf("the query has a complexity of %d, more than the maximum of %d", c.complexity, l.MaxComplexity)
+	}
+
+	return nil
+}
+
+type analysis struct {
+	schema    graphql.Schema
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]interface{}
+	// visiting guards against fragments spreading themselves, which the validation rejects later on.
+	visiting map[string]bool
+}
+
+func (a *analysis) selectionSet(parent *graphql.Object, set *ast.SelectionSet) cost {
+	var c cost
+	if set == nil || parent == nil {
+		return c
+	}
+
+	for _, selection := range set.Selections {
+		var s cost
+
+		switch selection := selection.(type) {
+		case *ast.Field:
+			s = a.field(parent, selection)
+		case *ast.InlineFragment:
+			s = a.selectionSet(a.typeCondition(parent, selection.TypeCondition), selection.SelectionSet)
+		case *ast.FragmentSpread:
+			name := selection.Name.Value
+			if fragment, ok := a.fragments[name]; ok && !a.visiting[name] {
+				a.visiting[name] = true
+				s = a.selectionSet(a.typeCondition(parent, fragment.TypeCondition), fragment.SelectionSet)
+				a.visiting[name] = false
+			}
+		}
+
+		c.complexity += s.complexity
+		if s.depth > c.depth {
+			c.depth = s.depth
+		}
+	}
+
+	return c
+}
+
+func (a *analysis) field(parent *graphql.Object, field *ast.Field) cost {
+	// The introspection fields are free, the tools introspecting the schema nest them deeply.
+	if strings.HasPrefix(field.Name.Value, "__") {
+		return cost{}
+	}
+
+	definition, ok := parent.Fields()[field.Name.Value]
+	if !ok {
+		return cost{depth: 1, complexity: 1}
+	}
+
+	object, list := unwrap(definition.Type)
+	children := a.selectionSet(object, field.SelectionSet)
+
+	multiplier := 1
+	if list {
+		multiplier = a.limit(field)
+	}
+
+	return cost{depth: children.depth + 1, complexity: 1 + multiplier*children.complexity}
+}
+
+// limit returns the limit argument of a list field, or the default list size.
+func (a *analysis) limit(field *ast.Field) int {
+	for _, argument := range field.Arguments {
+		if argument.Name.Value != "limit" {
+			continue
+		}
+
+		switch value := argument.Value.(type) {
+		case *ast.IntValue:
+			if limit, err := strconv.Atoi(value.Value); err == nil && limit >= 0 {
+				return limit
+			}
+		case *ast.Variable:
+			// Variables are decoded from JSON, so numbers are float64.
+			if limit, ok := a.variables[value.Name.Value].(float64); ok && limit >= 0 {
+				return int(limit)
+			}
+		}
+	}
+
+	return defaultListSize
+}
+
+func (a *analysis) typeCondition(parent *graphql.Object, condition *ast.Named) *graphql.Object {
+	if condition == nil {
+		return parent
+	}
+
+	object, _ := a.schema.Type(condition.Name.Value).(*graphql.Object)
+
+	return object
+}
+
+// unwrap returns the object type of a field, if any, and whether the field is a list.
+func unwrap(t graphql.Type) (*graphql.Object, bool) {
+	list := false
+
+	for {
+		switch typed := t.(type) {
+		case *graphql.NonNull:
+			t = typed.OfType
+		case *graphql.List:
+			list = true
+			t = typed.OfType
+		case *graphql.Object:
+			return typed, list
+		default:
+			return nil, list
+		}
+	}
+}
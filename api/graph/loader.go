@@ -0,0 +1,73 @@
+package graph
+
+import (
+	"context"
+	"sync"
+)
+
+// loader batches the loads of a field into a single backend call (dataloader style).
+//
+// The resolvers return the thunk of load instead of a value. The executor resolves the thunks breadth first, once all
+// the fields of a level have been resolved, so the first thunk called fetches the keys of all the fields of its level at once.
+type loader struct {
+	fetch func(ctx context.Context, keys []int64) (map[int64]interface{}, error)
+
+	mu      sync.Mutex
+	pending []int64
+	results map[int64]result
+}
+
+// result is the loaded value of a key, or the error of its batch.
+type result struct {
+	value interface{}
+	err   error
+}
+
+// newLoader instantiates and returns a new loader. fetch must return a value for every key.
+func newLoader(fetch func(ctx context.Context, keys []int64) (map[int64]interface{}, error)) *loader {
+	return &loader{fetch: fetch, results: map[int64]result{}}
+}
+
+// load queues a key for the next batch and returns a thunk resolving its value. Keys are only fetched once per request.
+func (l *loader) load(ctx context.Context, key int64) func() (interface{}, error) {
+	l.mu.Lock()
+	if _, ok := l.results[key]; !ok && !l.isPending(key) {
+		l.pending = append(l.pending, key)
+	}
+	l.mu.Unlock()
+
+	return func() (interface{}, error) {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		if _, ok := l.results[key]; !ok {
+			l.dispatch(ctx)
+		}
+
+		r := l.results[key]
+
+		return r.value, r.err
+	}
+}
+
+// dispatch fetches the pending keys.
+func (l *loader) dispatch(ctx context.Context) {
+	keys := l.pending
+	l.pending = nil
+
+	values, err := l.fetch(ctx, keys)
+
+	for _, key := range keys {
+		l.results[key] = result{value: values[key], err: err}
+	}
+}
+
+func (l *loader) isPending(key int64) bool {
+	for _, pending := range l.pending {
+		if pending == key {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,547 @@
+// Package graph serves a GraphQL endpoint over the racing and sports services, so that clients can fetch races,
+// meetings and sports events in one round trip with only the fields they need.
+//
+// The types are the messages of the gRPC services, with the same field names as the JSON of the REST routes.
+// The nested fields are resolved through per request loaders, which batch them into a single backend call.
+package graph
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"git.neds.sh/matty/entain/api/proto/racing"
+	"git.neds.sh/matty/entain/api/proto/sports"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// meeting groups the races of a racing meeting. The racing service has no meetings of its own, only their ids.
+type meeting struct {
+	ID int64 `json:"id"`
+}
+
+// dateTime serialises the timestamps of the messages as RFC 3339 strings, like the REST routes.
+var dateTime = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "DateTime",
+	Description: "An RFC 3339 timestamp, e.g. 2026-10-21T00:44:05Z",
+	Serialize: func(value interface{}) interface{} {
+		switch value := value.(type) {
+		case *timestamppb.Timestamp:
+			if value == nil {
+				return nil
+			}
+
+			return value.AsTime().Format(time.RFC3339Nano)
+		case time.Time:
+			return value.Format(time.RFC3339Nano)
+		default:
+			return nil
+		}
+	},
+	ParseValue: func(value interface{}) interface{} {
+		if s, ok := value.(string); ok {
+			if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+				return t
+			}
+		}
+
+		return nil
+	},
+	ParseLiteral: func(value ast.Value) interface{} {
+		if s, ok := value.(*ast.StringValue); ok {
+			if t, err := time.Parse(time.RFC3339Nano, s.Value); err == nil {
+				return t
+			}
+		}
+
+		return nil
+	},
+})
+
+// NewSchema returns the GraphQL schema mapped onto the racing and sports clients.
+func NewSchema(racingClient racing.RacingClient, sportsClient sports.SportsClient) (graphql.Schema, error) {
+	direction := graphql.NewEnum(graphql.EnumConfig{
+		Name: "Direction",
+		Values: graphql.EnumValueConfigMap{
+			"ASC":  {Value: "ASC", Description: "Ascending order"},
+			"DESC": {Value: "DESC", Description: "Descending order"},
+		},
+	})
+
+	orderBy := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name:        "OrderBy",
+		Description: "A field to order a list by, e.g. advertised_start_time",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"field":     {Type: graphql.NewNonNull(graphql.String)},
+			"direction": {Type: direction, DefaultValue: "ASC"},
+		},
+	})
+
+	venue := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Venue",
+		Fields: graphql.Fields{
+			"id":        {Type: graphql.NewNonNull(graphql.ID), Description: "Id of the venue, the same for the races and the sports events"},
+			"name":      {Type: graphql.String},
+			"city":      {Type: graphql.String},
+			"country":   {Type: graphql.String, Description: "ISO 3166-1 alpha-2 code, e.g. AU"},
+			"latitude":  {Type: graphql.Float},
+			"longitude": {Type: graphql.Float},
+			"timezone":  {Type: graphql.String, Description: "IANA time zone, e.g. Australia/Melbourne"},
+		},
+	})
+
+	selection := graphql.NewObject(graphql.ObjectConfig{
+		Name: "OutrightSelection",
+		Fields: graphql.Fields{
+			"id":     {Type: graphql.NewNonNull(graphql.ID)},
+			"name":   {Type: graphql.String},
+			"price":  {Type: graphql.Float},
+			"result": {Type: graphql.String, Description: "WON or LOST once the outright is settled"},
+		},
+	})
+
+	outright := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Outright",
+		Description: "An outright (futures) market of a race",
+		Fields: graphql.Fields{
+			"id":         {Type: graphql.NewNonNull(graphql.ID)},
+			"raceId":     {Type: graphql.NewNonNull(graphql.ID)},
+			"name":       {Type: graphql.String},
+			"selections": {Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(selection)))},
+			"closeTime":  {Type: dateTime},
+			"status":     {Type: graphql.String, Description: "OPEN, CLOSED or SETTLED"},
+		},
+	})
+
+	meetingType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Meeting",
+		Description: "A racing meeting",
+		Fields: graphql.Fields{
+			"id": {Type: graphql.NewNonNull(graphql.ID)},
+		},
+	})
+
+	race := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Race",
+		Fields: graphql.Fields{
+			"id":        {Type: graphql.NewNonNull(graphql.ID)},
+			"meetingId": {Type: graphql.NewNonNull(graphql.ID)},
+			"meeting": {
+				Type: graphql.NewNonNull(meetingType),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return &meeting{ID: p.Source.(*racing.Race).MeetingId}, nil
+				},
+			},
+			"name":                     {Type: graphql.String},
+			"number":                   {Type: graphql.Int},
+			"visible":                  {Type: graphql.Boolean},
+			"advertisedStartTime":      {Type: dateTime},
+			"advertisedStartLocalTime": {Type: graphql.String, Description: "Advertised start time in the time zone of the venue"},
+			"status":                   {Type: graphql.String, Description: "OPEN or CLOSED"},
+			"venue":                    {Type: venue},
+			"outrights": {
+				Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(outright))),
+				Description: "The outright markets of the race, settled ones included",
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return loadersFrom(p.Context).raceOutrights.load(p.Context, p.Source.(*racing.Race).Id), nil
+				},
+			},
+		},
+	})
+
+	// The races of a meeting refer back to their meeting, so the field is added once both types exist.
+	meetingType.AddFieldConfig("races", &graphql.Field{
+		Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(race))),
+		Description: "The races of the meeting",
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return loadersFrom(p.Context).meetingRaces.load(p.Context, p.Source.(*meeting).ID), nil
+		},
+	})
+
+	event := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Event",
+		Description: "A sports event",
+		Fields: graphql.Fields{
+			"id":                       {Type: graphql.NewNonNull(graphql.ID)},
+			"meetingId":                {Type: graphql.NewNonNull(graphql.ID)},
+			"name":                     {Type: graphql.String},
+			"number":                   {Type: graphql.Int},
+			"visible":                  {Type: graphql.Boolean},
+			"advertisedStartTime":      {Type: dateTime},
+			"advertisedStartLocalTime": {Type: graphql.String, Description: "Advertised start time in the time zone of the venue"},
+			"status":                   {Type: graphql.String, Description: "OPEN, CLOSED or FINISHED"},
+			"bettingClosedTime":        {Type: dateTime},
+			"homeTeam":                 {Type: graphql.String},
+			"awayTeam":                 {Type: graphql.String},
+			"competitionId":            {Type: graphql.ID},
+			"competition":              {Type: graphql.String},
+			"season":                   {Type: graphql.String},
+			"venue":                    {Type: venue},
+			"suspended":                {Type: graphql.Boolean, Description: "Whether the markets of the event are suspended"},
+		},
+	})
+
+	raceFilter := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "RaceFilter",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"meetingIds": {Type: graphql.NewList(graphql.NewNonNull(graphql.ID)), Description: "Only return the races of these meetings"},
+			"visible":    {Type: graphql.Boolean, Description: "Only return the visible or the hidden races"},
+			"venueIds":   {Type: graphql.NewList(graphql.NewNonNull(graphql.ID)), Description: "Only return the races taking place at these venues"},
+			"country":    {Type: graphql.String, Description: "Only return the races taking place in this country, e.g. AU"},
+		},
+	})
+
+	eventFilter := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "EventFilter",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"meetingIds": {Type: graphql.NewList(graphql.NewNonNull(graphql.ID)), Description: "Only return the sports events of these meetings"},
+			"visible":    {Type: graphql.Boolean, Description: "Only return the visible or the hidden sports events"},
+			"venueIds":   {Type: graphql.NewList(graphql.NewNonNull(graphql.ID)), Description: "Only return the sports events taking place at these venues"},
+			"country":    {Type: graphql.String, Description: "Only return the sports events taking place in this country, e.g. AU"},
+		},
+	})
+
+	listArgs := func(filter *graphql.InputObject) graphql.FieldConfigArgument {
+		return graphql.FieldConfigArgument{
+			"filter":  {Type: filter},
+			"orderBy": {Type: graphql.NewList(graphql.NewNonNull(orderBy))},
+			"limit":   {Type: graphql.Int, Description: "Maximum number of items to return"},
+		}
+	}
+
+	idArgs := graphql.FieldConfigArgument{
+		"id": {Type: graphql.NewNonNull(graphql.ID)},
+	}
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"races": {
+				Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(race))),
+				Description: "The races matching the filter",
+				Args:        listArgs(raceFilter),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					in, err := listRacesRequest(p.Args)
+					if err != nil {
+						return nil, err
+					}
+
+					response, err := racingClient.ListRaces(p.Context, in)
+					if err != nil {
+						return nil, err
+					}
+
+					return limitList(response.Races, p.Args)
+				},
+			},
+			"race": {
+				Type:        race,
+				Description: "A race by id",
+				Args:        idArgs,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					id, err := parseID(p.Args["id"])
+					if err != nil {
+						return nil, err
+					}
+
+					// Unknown ids resolve to null rather than to an error.
+					response, err := racingClient.GetRaceById(p.Context, &racing.GetRaceRequest{Id: id})
+					if status.Code(err) == codes.NotFound {
+						return nil, nil
+					}
+
+					if err != nil {
+						return nil, err
+					}
+
+					return response.Race, nil
+				},
+			},
+			"meeting": {
+				Type:        meetingType,
+				Description: "A racing meeting by id",
+				Args:        idArgs,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					id, err := parseID(p.Args["id"])
+					if err != nil {
+						return nil, err
+					}
+
+					return &meeting{ID: id}, nil
+				},
+			},
+			"events": {
+				Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(event))),
+				Description: "The sports events matching the filter",
+				Args:        listArgs(eventFilter),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					in, err := listEventsRequest(p.Args)
+					if err != nil {
+						return nil, err
+					}
+
+					response, err := sportsClient.ListEvents(p.Context, in)
+					if err != nil {
+						return nil, err
+					}
+
+					return limitList(response.Sports, p.Args)
+				},
+			},
+			"event": {
+				Type:        event,
+				Description: "A sports event by id",
+				Args:        idArgs,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					id, err := parseID(p.Args["id"])
+					if err != nil {
+						return nil, err
+					}
+
+					// Unknown ids resolve to null rather than to an error.
+					response, err := sportsClient.GetSportById(p.Context, &sports.GetSportRequest{Id: id})
+					if status.Code(err) == codes.NotFound {
+						return nil, nil
+					}
+
+					if err != nil {
+						return nil, err
+					}
+
+					return response.Sport, nil
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: query})
+}
+
+func listRacesRequest(args map[string]interface{}) (*racing.ListRacesRequest, error) {
+	in := &racing.ListRacesRequest{Filter: &racing.ListRacesRequestFilter{}}
+
+	if filter, ok := args["filter"].(map[string]interface{}); ok {
+		var err error
+
+		if in.Filter.MeetingIds, err = parseIDs(filter["meetingIds"]); err != nil {
+			return nil, err
+		}
+
+		if in.Filter.VenueIds, err = parseIDs(filter["venueIds"]); err != nil {
+			return nil, err
+		}
+
+		if visible, ok := filter["visible"].(bool); ok {
+			in.Filter.MeetingVisibility = &visible
+		}
+
+		in.Filter.Country, _ = filter["country"].(string)
+	}
+
+	if fields := orderByFields(args); len(fields) != 0 {
+		in.OrderBy = &racing.ListRacesRequestOrderBy{}
+
+		for _, field := range fields {
+			in.OrderBy.OrderByFields = append(in.OrderBy.OrderByFields, &racing.OrderByField{
+				Field:     field.name,
+				Direction: racing.OrderByField_Direction(racing.OrderByField_Direction_value[field.direction]),
+			})
+		}
+	}
+
+	return in, nil
+}
+
+func listEventsRequest(args map[string]interface{}) (*sports.ListEventsRequest, error) {
+	in := &sports.ListEventsRequest{Filter: &sports.ListEventsRequestFilter{}}
+
+	if filter, ok := args["filter"].(map[string]interface{}); ok {
+		var err error
+
+		if in.Filter.MeetingIds, err = parseIDs(filter["meetingIds"]); err != nil {
+			return nil, err
+		}
+
+		if in.Filter.VenueIds, err = parseIDs(filter["venueIds"]); err != nil {
+			return nil, err
+		}
+
+		if visible, ok := filter["visible"].(bool); ok {
+			in.Filter.MeetingVisibility = &visible
+		}
+
+		in.Filter.Country, _ = filter["country"].(string)
+	}
+
+	if fields := orderByFields(args); len(fields) != 0 {
+		in.OrderBy = &sports.ListEventsRequestOrderBy{}
+
+		for _, field := range fields {
+			in.OrderBy.OrderByFields = append(in.OrderBy.OrderByFields, &sports.OrderByField{
+				Field:     field.name,
+				Direction: sports.OrderByField_Direction(sports.OrderByField_Direction_value[field.direction]),
+			})
+		}
+	}
+
+	return in, nil
+}
+
+type orderByField struct {
+	name      string
+	direction string
+}
+
+func orderByFields(args map[string]interface{}) []orderByField {
+	var fields []orderByField
+
+	items, _ := args["orderBy"].([]interface{})
+	for _, item := range items {
+		item, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		field := orderByField{direction: "ASC"}
+		field.name, _ = item["field"].(string)
+		if direction, ok := item["direction"].(string); ok {
+			field.direction = direction
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields
+}
+
+// limitList applies the limit argument to a list returned by a backend, as the backends return all the matching items.
+func limitList(list interface{}, args map[string]interface{}) (interface{}, error) {
+	limit, ok := args["limit"].(int)
+	if !ok {
+		return list, nil
+	}
+
+	if limit < 0 {
+		return nil, fmt.Errorf("limit must be positive")
+	}
+
+	switch list := list.(type) {
+	case []*racing.Race:
+		if len(list) > limit {
+			return list[:limit], nil
+		}
+	case []*sports.Sport:
+		if len(list) > limit {
+			return list[:limit], nil
+		}
+	}
+
+	return list, nil
+}
+
+func parseID(value interface{}) (int64, error) {
+	s, _ := value.(string)
+
+	id, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid id %q", s)
+	}
+
+	return id, nil
+}
+
+func parseIDs(value interface{}) ([]int64, error) {
+	values, _ := value.([]interface{})
+
+	var ids []int64
+	for _, value := range values {
+		id, err := parseID(value)
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// loaders batch the nested fields of a request. They're per request so that nothing is cached across requests.
+type loaders struct {
+	meetingRaces  *loader
+	raceOutrights *loader
+}
+
+type loadersKey struct{}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+// newLoaders returns the loaders of a request.
+func newLoaders(racingClient racing.RacingClient) *loaders {
+	return &loaders{
+		// The races of all the meetings of a query are listed at once.
+		meetingRaces: newLoader(func(ctx context.Context, meetingIDs []int64) (map[int64]interface{}, error) {
+			response, err := racingClient.ListRaces(ctx, &racing.ListRacesRequest{
+				Filter: &racing.ListRacesRequestFilter{MeetingIds: meetingIDs},
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			races := map[int64][]*racing.Race{}
+			for _, race := range response.Races {
+				races[race.MeetingId] = append(races[race.MeetingId], race)
+			}
+
+			results := map[int64]interface{}{}
+			for _, id := range meetingIDs {
+				if races[id] == nil {
+					results[id] = []*racing.Race{}
+					continue
+				}
+
+				results[id] = races[id]
+			}
+
+			return results, nil
+		}),
+
+		// The racing service only filters outrights by a single race, so the outrights of several races come from a single
+		// unfiltered call. There are only a few of them, for the feature races.
+		raceOutrights: newLoader(func(ctx context.Context, raceIDs []int64) (map[int64]interface{}, error) {
+			in := &racing.ListOutrightsRequest{IncludeSettled: true}
+			if len(raceIDs) == 1 {
+				in.RaceId = raceIDs[0]
+			}
+
+			response, err := racingClient.ListOutrights(ctx, in)
+			if err != nil {
+				return nil, err
+			}
+
+			outrights := map[int64][]*racing.Outright{}
+			for _, outright := range response.Outrights {
+				outrights[outright.RaceId] = append(outrights[outright.RaceId], outright)
+			}
+
+			results := map[int64]interface{}{}
+			for _, id := range raceIDs {
+				if outrights[id] == nil {
+					results[id] = []*racing.Outright{}
+					continue
+				}
+
+				results[id] = outrights[id]
+			}
+
+			return results, nil
+		}),
+	}
+}
@@ -0,0 +1,134 @@
+// Package health serves the liveness and readiness endpoints of the gateway.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Statuses reported by the endpoints, the same as the gRPC health service's.
+const (
+	Serving    = "SERVING"
+	NotServing = "NOT_SERVING"
+)
+
+// Backend is a gRPC backend the gateway depends on.
+type Backend struct {
+	// Name identifies the backend in the readiness report, e.g. racing.
+	Name string
+	// Service is the service checked with the gRPC health service, e.g. racing.Racing.
+	Service string
+	Conn    *grpc.ClientConn
+}
+
+// Report is the body of the health endpoints.
+type Report struct {
+	Status       string                `json:"status"`
+	Dependencies map[string]Dependency `json:"dependencies,omitempty"`
+}
+
+// Dependency is the status of a dependency in a Report.
+type Dependency struct {
+	Status string `json:"status"`
+	// Error tells why the dependency isn't serving.
+	Error string `json:"error,omitempty"`
+	// Latency of the check in milliseconds.
+	Latency int64 `json:"latencyMs"`
+}
+
+// Checker checks the health of the backends.
+type Checker struct {
+	backends []Backend
+	timeout  time.Duration
+}
+
+// NewChecker instantiates and returns a new Checker. A backend that doesn't answer within the timeout isn't serving.
+func NewChecker(backends []Backend, timeout time.Duration) *Checker {
+	return &Checker{backends: backends, timeout: timeout}
+}
+
+// Register adds the liveness (/healthz) and readiness (/readyz) routes to the mux.
+//
+// The gateway is live as long as it answers, so that it isn't restarted because of a backend. It's ready when all the backends are serving.
+func (c *Checker) Register(mux *http.ServeMux) {
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		write(w, Report{Status: Serving})
+	})
+
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		write(w, c.Check(r.Context()))
+	})
+}
+
+// Check checks all the backends at once. The report is SERVING when all of them are.
+func (c *Checker) Check(ctx context.Context) Report {
+	report := Report{Status: Serving, Dependencies: map[string]Dependency{}}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for _, backend := range c.backends {
+		wg.Add(1)
+
+		go func(backend Backend) {
+			defer wg.Done()
+
+			dependency := c.check(ctx, backend)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			report.Dependencies[backend.Name] = dependency
+			if dependency.Status != Serving {
+				report.Status = NotServing
+			}
+		}(backend)
+	}
+
+	wg.Wait()
+
+	return report
+}
+
+func (c *Checker) check(ctx context.Context, backend Backend) Dependency {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	start := time.Now()
+
+	response, err := healthpb.NewHealthClient(backend.Conn).Check(ctx, &healthpb.HealthCheckRequest{Service: backend.Service})
+
+	dependency := Dependency{Status: NotServing, Latency: time.Since(start).Milliseconds()}
+
+	switch {
+	case err != nil:
+		dependency.Error = err.Error()
+	case response.Status != healthpb.HealthCheckResponse_SERVING:
+		dependency.Error = backend.Service + " is " + response.Status.String()
+	default:
+		dependency.Status = Serving
+	}
+
+	return dependency
+}
+
+// write writes the report, with a 503 when it isn't SERVING.
+func write(w http.ResponseWriter, report Report) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+
+	if report.Status != Serving {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		log.Debugf("failed writing health report: %s", err)
+	}
+}
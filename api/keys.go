@@ -0,0 +1,130 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+	"time"
+
+	"git.neds.sh/matty/entain/api/apikeys"
+)
+
+// manageKeys implements the keys sub command, which manages the API keys of partner integrations.
+//
+//	api keys [-file api-keys.json] create -owner name [-tier tier]
+//	api keys [-file api-keys.json] rotate id
+//	api keys [-file api-keys.json] revoke id
+//	api keys [-file api-keys.json] list
+//
+// A running gateway picks up the changes to the file within a second, there's no need to restart it.
+func manageKeys(args []string) error {
+	fs := flag.NewFlagSet("keys", flag.ExitOnError)
+	file := fs.String("file", "api-keys.json", "File the API keys are stored in. It must be the -api-keys-file of the gateway")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s keys [-file file] create -owner name [-tier tier] | rotate id | revoke id | list\n", filepath.Base(os.Args[0]))
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return errors.New("no keys command given")
+	}
+
+	store, err := apikeys.OpenStore(*file)
+	if err != nil {
+		return err
+	}
+
+	switch command, args := fs.Arg(0), fs.Args()[1:]; command {
+	case "create":
+		return createKey(store, args)
+	case "rotate":
+		if len(args) != 1 {
+			return errors.New("usage: keys rotate id")
+		}
+
+		key, apiKey, err := store.Rotate(args[0])
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Rotated the API key %s of %s. The previous key no longer works, hand over the new one:\n%s\n", key.ID, key.Owner, apiKey)
+	case "revoke":
+		if len(args) != 1 {
+			return errors.New("usage: keys revoke id")
+		}
+
+		key, err := store.Revoke(args[0])
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Revoked the API key %s of %s\n", key.ID, key.Owner)
+	case "list":
+		return listKeys(store)
+	default:
+		fs.Usage()
+		return fmt.Errorf("unknown keys command %q", command)
+	}
+
+	return nil
+}
+
+func createKey(store *apikeys.Store, args []string) error {
+	fs := flag.NewFlagSet("keys create", flag.ExitOnError)
+	owner := fs.String("owner", "", "Partner the key is issued to")
+	tier := fs.String("tier", "default", "Rate limit tier of the key, one of the tiers of the gateway's -rate-limits")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *owner == "" {
+		return errors.New("the owner of the key is required")
+	}
+
+	key, apiKey, err := store.Create(*owner, *tier)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Created the API key %s for %s in the %s tier. It isn't stored and won't be shown again:\n%s\n", key.ID, key.Owner, key.Tier, apiKey)
+
+	return nil
+}
+
+func listKeys(store *apikeys.Store) error {
+	keys, err := store.List()
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tOWNER\tTIER\tSTATUS\tCREATED\tROTATED")
+
+	for _, key := range keys {
+		state := "enabled"
+		if !key.Enabled {
+			state = "revoked " + formatTime(key.RevokedAt)
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", key.ID, key.Owner, key.Tier, state, formatTime(key.CreatedAt), formatTime(key.RotatedAt))
+	}
+
+	return w.Flush()
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+
+	return t.Format(time.RFC3339)
+}
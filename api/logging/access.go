@@ -0,0 +1,217 @@
+package logging
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	log "github.com/sirupsen/logrus"
+)
+
+// AccessLogger is an HTTP middleware giving every request a request id and logging one line per request.
+type AccessLogger struct {
+	route          func(path string) string
+	trustedProxies []*net.IPNet
+}
+
+// NewAccessLogger instantiates and returns a new AccessLogger. route returns the route logged for a path, e.g. /v1/races/{id}.
+// X-Forwarded-For is only read from the trustedProxies, the address of the connection is logged for the other requests.
+func NewAccessLogger(route func(path string) string, trustedProxies []*net.IPNet) *AccessLogger {
+	return &AccessLogger{route: route, trustedProxies: trustedProxies}
+}
+
+// ParseTrustedProxies parses a comma separated list of IP addresses and CIDR ranges like "10.0.0.0/8,192.168.1.10".
+func ParseTrustedProxies(value string) ([]*net.IPNet, error) {
+	var proxies []*net.IPNet
+
+	for _, proxy := range strings.Split(value, ",") {
+		proxy = strings.TrimSpace(proxy)
+		if proxy == "" {
+			continue
+		}
+
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q, expected an IP address or a CIDR range", proxy)
+			}
+
+			proxies = append(proxies, &net.IPNet{IP: ip, Mask: net.CIDRMask(len(ip)*8, len(ip)*8)})
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q, expected an IP address or a CIDR range", proxy)
+		}
+
+		proxies = append(proxies, network)
+	}
+
+	return proxies, nil
+}
+
+// entry collects what the handlers down the chain know about a request, see SetCaller.
+type entry struct {
+	caller string
+}
+
+type entryKey struct{}
+
+// SetCaller records who made a request, e.g. the subject of its bearer token, for its access log line.
+func SetCaller(ctx context.Context, caller string) {
+	if e, ok := ctx.Value(entryKey{}).(*entry); ok {
+		e.caller = caller
+	}
+}
+
+// Middleware logs the requests once they're done. It must come first so that the rejected requests are logged as well
+// and the request id is known to all the handlers.
+func (l *AccessLogger) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		id := requestID(r)
+		w.Header().Set(Header, id)
+
+		// The request id is forwarded by Metadata, clients must not be able to send another one to the backends.
+		r.Header.Del(runtime.MetadataHeaderPrefix + MetadataKey)
+
+		addr := l.remoteAddr(r)
+
+		e := &entry{}
+		ctx := context.WithValue(r.Context(), requestIDKey{}, id)
+		ctx = context.WithValue(ctx, entryKey{}, e)
+		ctx = context.WithValue(ctx, remoteAddrKey{}, addr)
+
+		sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(sw, r.WithContext(ctx))
+
+		caller := e.caller
+		if caller == "" {
+			caller = "anonymous"
+		}
+
+		log.WithFields(log.Fields{
+			"method":      r.Method,
+			"route":       l.route(r.URL.Path),
+			"status":      sw.status,
+			"latency_ms":  float64(time.Since(start).Microseconds()) / 1000,
+			"request_id":  id,
+			"caller":      caller,
+			"remote_addr": addr,
+			"bytes":       sw.bytes,
+		}).Info("request")
+	})
+}
+
+type remoteAddrKey struct{}
+
+// RemoteAddr returns the address of the client of a request, as logged by the access logger.
+func RemoteAddr(r *http.Request) string {
+	if addr, ok := r.Context().Value(remoteAddrKey{}).(string); ok {
+		return addr
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
+
+// remoteAddr returns the address of the client. Behind trusted proxies, it's the last address of X-Forwarded-For that
+// isn't one of them, as the ones before it can be made up by the client.
+func (l *AccessLogger) remoteAddr(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	if !l.trusted(host) {
+		return host
+	}
+
+	forwarded := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		addr := strings.TrimSpace(forwarded[i])
+		if addr == "" {
+			continue
+		}
+
+		host = addr
+		if !l.trusted(addr) {
+			break
+		}
+	}
+
+	return host
+}
+
+// trusted reports whether the address is one of the trusted proxies.
+func (l *AccessLogger) trusted(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+
+	for _, proxy := range l.trustedProxies {
+		if proxy.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// statusWriter keeps the status code and the size of the response.
+type statusWriter struct {
+	http.ResponseWriter
+	status      int
+	bytes       int
+	wroteHeader bool
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.status, w.wroteHeader = status, true
+	}
+
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *statusWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+
+	n, err := w.ResponseWriter.Write(b)
+	w.bytes += n
+
+	return n, err
+}
+
+// Flush is needed by the streaming routes, e.g. the watch route of sports events.
+func (w *statusWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Hijack is needed by the WebSocket route, which takes over the connection once it's upgraded.
+func (w *statusWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("the connection can't be hijacked")
+	}
+
+	w.status, w.wroteHeader = http.StatusSwitchingProtocols, true
+
+	return h.Hijack()
+}
@@ -0,0 +1,34 @@
+// Package logging configures the logs of the gateway and writes an access log line per request.
+//
+// Every request gets a request id, which is echoed back in the X-Request-ID header, forwarded to the backends in the
+// gRPC metadata and added to the error bodies, so that a request can be followed from the client to the backends' logs.
+package logging
+
+import (
+	"fmt"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Configure sets the level (e.g. debug, info, warn) and the format (json or text) of the logs.
+func Configure(level string, format string) error {
+	lvl, err := log.ParseLevel(level)
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case "json":
+		log.SetFormatter(&log.JSONFormatter{})
+	case "text":
+		log.SetFormatter(&log.TextFormatter{FullTimestamp: true})
+	default:
+		return fmt.Errorf("invalid log format %q, expected json or text", format)
+	}
+
+	log.SetLevel(lvl)
+	log.SetOutput(os.Stdout)
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"regexp"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// Header is the request and response header carrying the request id.
+	Header = "X-Request-ID"
+	// MetadataKey is the metadata key the request id is forwarded to the backends with.
+	MetadataKey = "x-request-id"
+)
+
+// validRequestID restricts the request ids given by the clients, as they end up in the logs of every service.
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+type requestIDKey struct{}
+
+// RequestID returns the request id of a request's context.
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+
+	return id
+}
+
+// requestID returns the request id given by the client, or a new one when it's missing or invalid.
+func requestID(r *http.Request) string {
+	if id := r.Header.Get(Header); validRequestID.MatchString(id) {
+		return id
+	}
+
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		log.Errorf("failed generating request id: %s", err)
+	}
+
+	return hex.EncodeToString(b)
+}
+
+// Metadata forwards the request id to the backends. Use it with runtime.WithMetadata.
+func Metadata(ctx context.Context, r *http.Request) metadata.MD {
+	id := RequestID(r.Context())
+	if id == "" {
+		return nil
+	}
+
+	return metadata.Pairs(MetadataKey, id)
+}
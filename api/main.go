@@ -4,23 +4,100 @@ import (
 	"context"
 	"flag"
 	"net/http"
+	"time"
 
+	"git.neds.sh/matty/entain/api/apierror"
+	"git.neds.sh/matty/entain/api/apikeys"
+	"git.neds.sh/matty/entain/api/auth"
+	"git.neds.sh/matty/entain/api/backend"
+	"git.neds.sh/matty/entain/api/cache"
+	"git.neds.sh/matty/entain/api/cors"
+	"git.neds.sh/matty/entain/api/docs"
+	"git.neds.sh/matty/entain/api/feed"
+	"git.neds.sh/matty/entain/api/graph"
+	"git.neds.sh/matty/entain/api/health"
+	"git.neds.sh/matty/entain/api/logging"
+	"git.neds.sh/matty/entain/api/metrics"
 	"git.neds.sh/matty/entain/api/proto/racing"
 	"git.neds.sh/matty/entain/api/proto/sports"
+	"git.neds.sh/matty/entain/api/proto/upcoming"
+	"git.neds.sh/matty/entain/api/query"
+	"git.neds.sh/matty/entain/api/webrpc"
+	"git.neds.sh/matty/entain/api/ws"
+	"git.neds.sh/matty/entain/common/tlsconfig"
+	"git.neds.sh/matty/entain/common/tracing"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"google.golang.org/grpc"
 )
 
 var (
 	apiEndpoint        = flag.String("api-endpoint", "localhost:8000", "API endpoint")
-	grpcRacingEndpoint = flag.String("grpc-racing-endpoint", "localhost:9000", "gRPC racing server endpoint")
-	grpcSportsEndpoint = flag.String("grpc-sports-endpoint", "localhost:9001", "gRPC sports server endpoint")
+	grpcRacingEndpoint = flag.String("grpc-racing-endpoint", "localhost:9000", "gRPC racing server endpoints: a comma separated list of addresses, or a DNS name resolving to all of them like dns:///racing:9000")
+	grpcSportsEndpoint = flag.String("grpc-sports-endpoint", "localhost:9001", "gRPC sports server endpoints: a comma separated list of addresses, or a DNS name resolving to all of them like dns:///sports:9001")
+	tlsCert            = flag.String("tls-cert", "", "PEM certificate chain the API is served with over HTTPS, reloaded when it changes. The API is served over HTTP when it's not set")
+	tlsKey             = flag.String("tls-key", "", "PEM private key of -tls-cert, reloaded when it changes")
+	tlsClientCA        = flag.String("tls-client-ca", "", "PEM CA certificates the client certificates are verified against, which are then required (mutual TLS)")
+	backendCA          = flag.String("backend-tls-ca", "", "PEM CA certificates the certificates of the racing and sports servers are verified against. The servers are called over TLS when it or -backend-tls-cert is set")
+	backendCert        = flag.String("backend-tls-cert", "", "PEM client certificate chain presented to the racing and sports servers (mutual TLS), reloaded when it changes")
+	backendKey         = flag.String("backend-tls-key", "", "PEM private key of -backend-tls-cert, reloaded when it changes")
+	backendServerName  = flag.String("backend-tls-server-name", "", "Name the certificates of the racing and sports servers are verified against, in place of the host of their endpoints")
+	jwksFile           = flag.String("jwks-file", "", "JWKS file with the keys used to verify bearer tokens (HS256 and RS256). Authentication is disabled when it's not set")
+	jwtIssuer          = flag.String("jwt-issuer", "", "Expected issuer (iss) of bearer tokens")
+	jwtAudience        = flag.String("jwt-audience", "", "Expected audience (aud) of bearer tokens")
+	publicRoutes       = flag.String("public-routes", "GET /v1/**,POST /v1/list-races,POST /v1/list-sports,GET /graphql,POST /graphql", "Comma separated routes that can be called without a bearer token or an API key. * matches a path segment and a trailing ** the rest of the path")
+	apiKeysFile        = flag.String("api-keys-file", "", "File with the API keys of partner integrations, managed with the keys sub command. API keys are not required when it's not set")
+	backendTimeout     = flag.Duration("backend-timeout", 5*time.Second, "Deadline of the calls to the backends, unless the route has a timeout of its own or the client sets a grpc-timeout. The streams of updates have none")
+	routeTimeouts      = flag.String("route-timeouts", "", "Comma separated route=timeout deadlines of the backend calls of routes, in place of -backend-timeout, e.g. GET /v1/upcoming=3s")
+	backendAttempts    = flag.Int("backend-max-attempts", 3, "Number of times the reads are tried when a backend is unavailable, at most 5. The reads aren't retried with 1")
+	breakerFailures    = flag.Int("breaker-failures", 5, "Number of backend calls in a row that fail or time out before the circuit breaker of the backend trips. The breakers are disabled with 0")
+	breakerCooldown    = flag.Duration("breaker-cooldown", 10*time.Second, "How long a tripped circuit breaker fails the calls to its backend fast, with a 503, before probing it again")
+	ejectFailures      = flag.Int("eject-failures", 3, "Number of calls in a row to a backend endpoint that fail or time out before it's ejected. The endpoints are never ejected with 0")
+	ejectDuration      = flag.Duration("eject-duration", 30*time.Second, "How long an ejected backend endpoint is left out of the calls")
+	upcomingTimeout    = flag.Duration("upcoming-timeout", 2*time.Second, "How long /v1/upcoming waits for each backend before leaving its races or sports events out of the feed")
+	maxQueryDepth      = flag.Int("graphql-max-depth", 8, "How deeply the fields of a GraphQL query can be nested")
+	maxQueryComplexity = flag.Int("graphql-max-complexity", 5000, "Maximum complexity of a GraphQL query, i.e. the number of fields it can resolve, counting the fields of lists once per item")
+	readinessTimeout   = flag.Duration("readiness-timeout", 2*time.Second, "How long /readyz waits for each backend's health check")
+	otlpEndpoint       = flag.String("otlp-endpoint", "", "Endpoint of the OTLP collector the traces are exported to over gRPC, e.g. localhost:4317")
+	traceOutput        = flag.String("trace-output", "none", "File the traces are written to when there's no OTLP collector, or none to drop them")
+	rateLimits         = flag.String("rate-limits", "default=5:10,anonymous=2:10,free=1:5,standard=10:20,partner=50:100", "Comma separated tier=rate:burst limits of the API keys, per key and route. rate is in requests per second. The anonymous tier limits the public routes called without an API key, per client address")
+	cacheRules         = flag.String("cache-rules", "POST /v1/list-races=10s,GET /v1/races=10s,GET /v1/races/*=30s", "Comma separated route=ttl rules of the responses to cache. Caching is disabled when it's empty")
+	cacheSize          = flag.Int("cache-size", 32, "Maximum memory used by the cached responses, in MB")
+	wsSendQueue        = flag.Int("ws-send-queue", 256, "Number of messages queued for a WebSocket connection before it's closed for being too slow")
+	wsMaxTopics        = flag.Int("ws-max-topics", 100, "Maximum number of topics a WebSocket connection can subscribe to")
+	wsPingInterval     = flag.Duration("ws-ping-interval", 30*time.Second, "How often the WebSocket connections are pinged")
+	wsPongTimeout      = flag.Duration("ws-pong-timeout", 10*time.Second, "How long a WebSocket connection has to answer a ping before it's closed")
+	trustedProxies     = flag.String("trusted-proxies", "", "Comma separated IP addresses and CIDR ranges of the proxies in front of the gateway, whose X-Forwarded-For is trusted for the client address")
+	corsOrigins        = flag.String("cors-origins", "", "Comma separated origins of the browser apps allowed to call the gateway, e.g. https://tools.example.com. * allows any origin. CORS is disabled when it's empty")
+	logLevel           = flag.String("log-level", "info", "Level of the logs: debug, info, warn or error")
+	logFormat          = flag.String("log-format", "json", "Format of the logs: json or text")
 )
 
 func main() {
 	flag.Parse()
 
+	if err := logging.Configure(*logLevel, *logFormat); err != nil {
+		log.Fatalf("failed configuring logs: %s", err)
+	}
+
+	if flag.Arg(0) == "keys" {
+		if err := manageKeys(flag.Args()[1:]); err != nil {
+			log.Fatalf("failed managing api keys: %s", err)
+		}
+
+		return
+	}
+
+	if flag.Arg(0) == "gen-certs" {
+		if err := genCerts(flag.Args()[1:]); err != nil {
+			log.Fatalf("failed generating certificates: %s", err)
+		}
+
+		return
+	}
+
 	if err := run(); err != nil {
 		log.Fatalf("failed running api server: %s", err)
 	}
@@ -31,26 +108,334 @@ func run() error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	mux := runtime.NewServeMux()
-	if err := racing.RegisterRacingHandlerFromEndpoint(
-		ctx,
-		mux,
-		*grpcRacingEndpoint,
-		[]grpc.DialOption{grpc.WithInsecure()},
-	); err != nil {
+	shutdownTracing, err := tracing.Init(ctx, "api", *otlpEndpoint, *traceOutput)
+	if err != nil {
+		return err
+	}
+	defer shutdownTracing(context.Background())
+
+	creds, err := backendCredentials()
+	if err != nil {
+		return err
+	}
+
+	// The tracing interceptors propagate the trace of each request to the backends in the gRPC metadata.
+	dialOptions := []grpc.DialOption{
+		creds,
+		grpc.WithChainUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(otelgrpc.StreamClientInterceptor()),
+	}
+
+	backendConfig := backend.Config{
+		Timeout:         *backendTimeout,
+		MaxAttempts:     *backendAttempts,
+		BreakerFailures: *breakerFailures,
+		BreakerCooldown: *breakerCooldown,
+		EjectFailures:   *ejectFailures,
+		EjectDuration:   *ejectDuration,
+	}
+
+	racingOptions, err := backend.DialOptions("racing", "racing.Racing", backendConfig)
+	if err != nil {
+		return err
+	}
+
+	sportsOptions, err := backend.DialOptions("sports", "sports.Sports", backendConfig)
+	if err != nil {
+		return err
+	}
+
+	mux := runtime.NewServeMux(
+		runtime.WithMetadata(logging.Metadata),
+		runtime.WithMetadata(auth.Metadata),
+		runtime.WithMetadata(apikeys.Metadata),
+		runtime.WithErrorHandler(apierror.ErrorHandler),
+		runtime.SetQueryParameterParser(query.NewParser()),
+	)
+
+	// The connections are shared by the proxied routes and the upcoming feed, which is served by the gateway itself.
+	racingConn, err := grpc.DialContext(ctx, backend.Target(*grpcRacingEndpoint), append(dialOptions, racingOptions...)...)
+	if err != nil {
+		return err
+	}
+	defer racingConn.Close()
+
+	sportsConn, err := grpc.DialContext(ctx, backend.Target(*grpcSportsEndpoint), append(dialOptions, sportsOptions...)...)
+	if err != nil {
+		return err
+	}
+	defer sportsConn.Close()
+
+	if err := racing.RegisterRacingHandler(ctx, mux, racingConn); err != nil {
+		return err
+	}
+
+	if err := sports.RegisterSportsHandler(ctx, mux, sportsConn); err != nil {
 		return err
 	}
 
-	if err := sports.RegisterSportsHandlerFromEndpoint(
-		ctx,
-		mux,
-		*grpcSportsEndpoint,
-		[]grpc.DialOption{grpc.WithInsecure()},
-	); err != nil {
+	upcomingService := feed.NewService(racing.NewRacingClient(racingConn), sports.NewSportsClient(sportsConn), *upcomingTimeout)
+	if err := upcoming.RegisterUpcomingHandlerServer(ctx, mux, upcomingService); err != nil {
+		return err
+	}
+
+	schema, err := graph.NewSchema(racing.NewRacingClient(racingConn), sports.NewSportsClient(sportsConn))
+	if err != nil {
+		return err
+	}
+
+	// GraphQL and the WebSocket go through the same authentication as the REST routes, and the GraphQL resolvers
+	// forward the same metadata.
+	api := http.NewServeMux()
+	api.Handle("/graphql", graph.NewHandler(schema, racing.NewRacingClient(racingConn), graph.Limits{
+		MaxDepth:      *maxQueryDepth,
+		MaxComplexity: *maxQueryComplexity,
+	}, logging.Metadata, auth.Metadata, apikeys.Metadata))
+	api.Handle("/v1/ws", ws.NewHandler(racing.NewRacingClient(racingConn), sports.NewSportsClient(sportsConn), ws.Config{
+		SendQueue:    *wsSendQueue,
+		MaxTopics:    *wsMaxTopics,
+		PingInterval: *wsPingInterval,
+		PongTimeout:  *wsPongTimeout,
+	}))
+	api.Handle("/", mux)
+
+	// Browsers can call the services directly over gRPC-Web and Connect, through the same authentication as well.
+	webHandler, err := webrpc.NewHandler([]webrpc.Backend{
+		{Service: "racing.Racing", Conn: racingConn},
+		{Service: "sports.Sports", Conn: sportsConn},
+	}, logging.Metadata, auth.Metadata, apikeys.Metadata)
+	if err != nil {
+		return err
+	}
+	webHandler.Register(api)
+
+	routes, err := docs.Paths()
+	if err != nil {
+		return err
+	}
+
+	routes = append(routes, webHandler.Routes()...)
+
+	// The recorder labels the requests with their route, for the metrics, the traces, the logs and the rate limits.
+	recorder := metrics.NewRecorder(append(routes, "/graphql", "/v1/ws", "/openapi.json", "/docs/**", "/healthz", "/readyz", "/metrics", "/debug/backends"))
+
+	handler, err := limitRoutes(api)
+	if err != nil {
+		return err
+	}
+
+	if handler, err = cacheResponses(ctx, racing.NewRacingClient(racingConn), handler); err != nil {
+		return err
+	}
+
+	if handler, err = authenticate(mux, handler); err != nil {
+		return err
+	}
+
+	if handler, err = requireAPIKeys(mux, recorder.Route, handler); err != nil {
+		return err
+	}
+
+	if handler, err = allowOrigins(handler); err != nil {
+		return err
+	}
+
+	checker, err := healthChecker(creds)
+	if err != nil {
+		return err
+	}
+
+	// The API docs, the health endpoints, the metrics and the state of the backends are public and served outside of the
+	// authentication.
+	root := http.NewServeMux()
+	docs.Register(root)
+	checker.Register(root)
+	root.Handle("/metrics", metrics.Handler())
+	root.Handle("/debug/backends", backend.Handler())
+	root.Handle("/", handler)
+
+	// Every request gets a span named after its route. The probes and the scrapes aren't traced, they'd drown the rest.
+	traced := otelhttp.NewHandler(root, "api",
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return r.Method + " " + recorder.Route(r.URL.Path)
+		}),
+		otelhttp.WithFilter(func(r *http.Request) bool {
+			return r.URL.Path != "/healthz" && r.URL.Path != "/readyz" && r.URL.Path != "/metrics"
+		}),
+	)
+
+	proxies, err := logging.ParseTrustedProxies(*trustedProxies)
+	if err != nil {
 		return err
 	}
 
 	log.Infof("API server listening on: %s", *apiEndpoint)
 
-	return http.ListenAndServe(*apiEndpoint, mux)
+	accessLogger := logging.NewAccessLogger(recorder.Route, proxies)
+
+	return listen(accessLogger.Middleware(recorder.Middleware(traced)))
+}
+
+// listen serves the handler over HTTPS when there's a certificate, and HTTP otherwise.
+func listen(handler http.Handler) error {
+	server := &http.Server{Addr: *apiEndpoint, Handler: handler}
+
+	if *tlsCert == "" {
+		return server.ListenAndServe()
+	}
+
+	config, err := tlsconfig.Server(tlsconfig.Files{Cert: *tlsCert, Key: *tlsKey, CA: *tlsClientCA})
+	if err != nil {
+		return err
+	}
+
+	server.TLSConfig = config
+
+	// The certificate comes from the TLS config, which reloads it when it changes.
+	return server.ListenAndServeTLS("", "")
+}
+
+// backendCredentials returns the credentials of the connections to the backends: TLS, and mutual TLS with a client
+// certificate, when the flags are set.
+func backendCredentials() (grpc.DialOption, error) {
+	if *backendCA == "" && *backendCert == "" {
+		return grpc.WithInsecure(), nil
+	}
+
+	creds, err := tlsconfig.Client(tlsconfig.Files{Cert: *backendCert, Key: *backendKey, CA: *backendCA}, *backendServerName)
+	if err != nil {
+		return nil, err
+	}
+
+	return grpc.WithTransportCredentials(creds), nil
+}
+
+// healthChecker connects to the backends' gRPC health services for the readiness endpoint. The checks go to the
+// endpoints that are serving, so a backend is ready as long as one of its endpoints is.
+func healthChecker(creds grpc.DialOption) (*health.Checker, error) {
+	racingConn, err := grpc.Dial(backend.Target(*grpcRacingEndpoint), creds, backend.RoundRobin("racing.Racing"))
+	if err != nil {
+		return nil, err
+	}
+
+	sportsConn, err := grpc.Dial(backend.Target(*grpcSportsEndpoint), creds, backend.RoundRobin("sports.Sports"))
+	if err != nil {
+		return nil, err
+	}
+
+	return health.NewChecker([]health.Backend{
+		{Name: "racing", Service: "racing.Racing", Conn: racingConn},
+		{Name: "sports", Service: "sports.Sports", Conn: sportsConn},
+	}, *readinessTimeout), nil
+}
+
+// limitRoutes wraps the handler with the route timeouts, unless there are none.
+func limitRoutes(next http.Handler) (http.Handler, error) {
+	timeouts, err := backend.ParseRouteTimeouts(*routeTimeouts)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(timeouts) == 0 {
+		return next, nil
+	}
+
+	return backend.NewRouteTimeouts(timeouts).Middleware(next), nil
+}
+
+// cacheResponses wraps the handler with the response cache, unless there are no cache rules. The cached races are
+// invalidated from the watch stream of the racing service until the context is done.
+func cacheResponses(ctx context.Context, racingClient racing.RacingClient, next http.Handler) (http.Handler, error) {
+	rules, err := cache.ParseRules(*cacheRules)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rules) == 0 {
+		return next, nil
+	}
+
+	c := cache.NewCache(rules, *cacheSize<<20)
+	go c.Watch(ctx, racingClient)
+
+	return c.Middleware(next), nil
+}
+
+// allowOrigins wraps the handler with the CORS handling, unless no origins are given. It comes before the
+// authentication, which would reject the preflight requests.
+func allowOrigins(next http.Handler) (http.Handler, error) {
+	origins, err := cors.ParseOrigins(*corsOrigins)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(origins) == 0 {
+		return next, nil
+	}
+
+	return cors.NewCORS(origins).Middleware(next), nil
+}
+
+// authenticate wraps the handler with the bearer token authentication, unless no JWKS file is given.
+func authenticate(mux *runtime.ServeMux, next http.Handler) (http.Handler, error) {
+	if *jwksFile == "" {
+		if *apiKeysFile == "" {
+			log.Warn("no JWKS file given, all the routes can be called without authentication")
+		}
+
+		return next, nil
+	}
+
+	keys, err := auth.LoadKeySet(*jwksFile)
+	if err != nil {
+		return nil, err
+	}
+
+	routes, err := auth.ParseRoutes(*publicRoutes)
+	if err != nil {
+		return nil, err
+	}
+
+	authenticator := auth.NewAuthenticator(auth.Config{
+		Keys:         keys,
+		Issuer:       *jwtIssuer,
+		Audience:     *jwtAudience,
+		PublicRoutes: routes,
+	}, mux)
+
+	return authenticator.Middleware(next), nil
+}
+
+// requireAPIKeys wraps the handler with the API key authentication and rate limiting, unless no API keys file is given.
+// Requests with a bearer token skip it when the bearer token authentication is enabled, and the public routes are rate
+// limited per client address when they're called without an API key.
+func requireAPIKeys(mux *runtime.ServeMux, route func(path string) string, next http.Handler) (http.Handler, error) {
+	if *apiKeysFile == "" {
+		return next, nil
+	}
+
+	limits, err := apikeys.ParseLimits(*rateLimits)
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := apikeys.OpenStore(*apiKeysFile)
+	if err != nil {
+		return nil, err
+	}
+
+	routes, err := auth.ParseRoutes(*publicRoutes)
+	if err != nil {
+		return nil, err
+	}
+
+	authenticator := apikeys.NewAuthenticator(apikeys.Config{
+		Store:        store,
+		Limiter:      apikeys.NewLimiter(limits),
+		Route:        route,
+		PublicRoutes: routes,
+		BearerTokens: *jwksFile != "",
+	}, mux)
+
+	return authenticator.Middleware(next), nil
 }
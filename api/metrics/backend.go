@@ -0,0 +1,28 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	circuitBreakerState = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "entain_backend_circuit_breaker_state",
+		Help: "State of the circuit breaker of each backend: 0 closed, 1 half open (probing the backend) and 2 open (failing fast).",
+	}, []string{"backend"})
+
+	circuitBreakerRejected = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "entain_backend_circuit_breaker_rejected_total",
+		Help: "Number of backend calls failed fast by the circuit breaker of each backend.",
+	}, []string{"backend"})
+)
+
+// SetCircuitBreakerState records the state of the circuit breaker of a backend.
+func SetCircuitBreakerState(backend string, state int) {
+	circuitBreakerState.WithLabelValues(backend).Set(float64(state))
+}
+
+// CircuitBreakerRejected counts a call failed fast by the circuit breaker of a backend.
+func CircuitBreakerRejected(backend string) {
+	circuitBreakerRejected.WithLabelValues(backend).Inc()
+}
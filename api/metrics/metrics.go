@@ -0,0 +1,147 @@
+// Package metrics exposes the Prometheus metrics of the gateway.
+//
+// The metric names and labels are the same in the API gateway and in the racing and sports services.
+package metrics
+
+import (
+	"bufio"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// otherRoute is the route label of the requests that don't match any known route, so that unknown paths can't blow up the number of series.
+const otherRoute = "other"
+
+var (
+	httpRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "entain_http_requests_total",
+		Help: "Number of HTTP requests handled, by method, route and status code.",
+	}, []string{"method", "route", "code"})
+
+	httpRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "entain_http_request_duration_seconds",
+		Help:    "Duration of the HTTP requests, by method, route and status code. Streams are measured until they end.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "route", "code"})
+
+	httpRequestsInFlight = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "entain_http_requests_in_flight",
+		Help: "Number of HTTP requests being handled, by method and route.",
+	}, []string{"method", "route"})
+)
+
+// Handler serves the metrics.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// Recorder is an HTTP middleware recording the metrics of the requests.
+type Recorder struct {
+	routes [][]string
+}
+
+// NewRecorder instantiates and returns a new Recorder. The requests are labelled with the first of the given routes they match,
+// e.g. /v1/races/{id}. A segment in braces matches any path segment and a trailing ** matches the rest of the path.
+func NewRecorder(routes []string) *Recorder {
+	r := &Recorder{}
+
+	for _, route := range routes {
+		r.routes = append(r.routes, strings.Split(strings.Trim(route, "/"), "/"))
+	}
+
+	return r
+}
+
+// Middleware records the metrics of the requests. It must come first so that the rejected requests are recorded as well.
+func (rec *Recorder) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		route := rec.Route(r.URL.Path)
+
+		inFlight := httpRequestsInFlight.WithLabelValues(r.Method, route)
+		inFlight.Inc()
+		defer inFlight.Dec()
+
+		sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(sw, r)
+
+		code := strconv.Itoa(sw.status)
+		httpRequests.WithLabelValues(r.Method, route, code).Inc()
+		httpRequestDuration.WithLabelValues(r.Method, route, code).Observe(time.Since(start).Seconds())
+	})
+}
+
+// Route returns the route a path is labelled with, e.g. /v1/races/{id} for /v1/races/1.
+func (rec *Recorder) Route(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	for _, route := range rec.routes {
+		if matches(route, segments) {
+			return "/" + strings.Join(route, "/")
+		}
+	}
+
+	return otherRoute
+}
+
+func matches(route []string, segments []string) bool {
+	for i, part := range route {
+		if part == "**" {
+			return true
+		}
+
+		if i >= len(segments) || (part != segments[i] && !(strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}"))) {
+			return false
+		}
+	}
+
+	return len(route) == len(segments)
+}
+
+// statusWriter keeps the status code of the response.
+type statusWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.status, w.wroteHeader = status, true
+	}
+
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *statusWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush is needed by the streaming routes, e.g. the watch route of sports events.
+func (w *statusWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Hijack is needed by the WebSocket route, which takes over the connection once it's upgraded.
+func (w *statusWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("the connection can't be hijacked")
+	}
+
+	w.status, w.wroteHeader = http.StatusSwitchingProtocols, true
+
+	return h.Hijack()
+}
@@ -2,3 +2,5 @@ package proto
 
 //go:generate protoc -I . --go_out . --go_opt paths=source_relative --go-grpc_out . --go-grpc_opt paths=source_relative --grpc-gateway_out . --grpc-gateway_opt paths=source_relative racing/racing.proto
 //go:generate protoc -I . --go_out . --go_opt paths=source_relative --go-grpc_out . --go-grpc_opt paths=source_relative --grpc-gateway_out . --grpc-gateway_opt paths=source_relative sports/sports.proto
+//go:generate protoc -I . --go_out . --go_opt paths=source_relative,Mracing/racing.proto=git.neds.sh/matty/entain/api/proto/racing,Msports/sports.proto=git.neds.sh/matty/entain/api/proto/sports --go-grpc_out . --go-grpc_opt paths=source_relative,Mracing/racing.proto=git.neds.sh/matty/entain/api/proto/racing,Msports/sports.proto=git.neds.sh/matty/entain/api/proto/sports --grpc-gateway_out . --grpc-gateway_opt paths=source_relative upcoming/upcoming.proto
+//go:generate protoc -I . --openapiv2_out ../docs --openapiv2_opt allow_merge=true,merge_file_name=api racing/racing.proto sports/sports.proto upcoming/upcoming.proto
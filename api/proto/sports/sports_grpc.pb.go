@@ -22,6 +22,36 @@ type SportsClient interface {
 	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
 	// Get race details by id
 	GetSportById(ctx context.Context, in *GetSportRequest, opts ...grpc.CallOption) (*GetSportResponse, error)
+	// GetEventResult returns the latest result of a sports event along with its revision history
+	GetEventResult(ctx context.Context, in *GetEventResultRequest, opts ...grpc.CallOption) (*GetEventResultResponse, error)
+	// RecordEventResult records a new result revision for a sports event. Use this to enter and correct results
+	RecordEventResult(ctx context.Context, in *RecordEventResultRequest, opts ...grpc.CallOption) (*RecordEventResultResponse, error)
+	// AddIncident adds an incident (goal, card, substitution etc.) to the timeline of a sports event
+	AddIncident(ctx context.Context, in *AddIncidentRequest, opts ...grpc.CallOption) (*AddIncidentResponse, error)
+	// ListIncidents returns the timeline of a sports event. Use since_sequence to only fetch the incidents added since the last call
+	ListIncidents(ctx context.Context, in *ListIncidentsRequest, opts ...grpc.CallOption) (*ListIncidentsResponse, error)
+	// WatchEvent streams the live updates (incidents and results) of a sports event
+	WatchEvent(ctx context.Context, in *WatchEventRequest, opts ...grpc.CallOption) (Sports_WatchEventClient, error)
+	// GetStandings returns the ladder of a competition season computed from the final results
+	GetStandings(ctx context.Context, in *GetStandingsRequest, opts ...grpc.CallOption) (*GetStandingsResponse, error)
+	// GetHeadToHead returns the recent meetings between two teams and a summary of their head to head record
+	GetHeadToHead(ctx context.Context, in *GetHeadToHeadRequest, opts ...grpc.CallOption) (*GetHeadToHeadResponse, error)
+	// ListMarkets returns the markets of a sports event along with their suspended state
+	ListMarkets(ctx context.Context, in *ListMarketsRequest, opts ...grpc.CallOption) (*ListMarketsResponse, error)
+	// SuspendEvent suspends all the markets of a sports event at once, e.g. when a goal is scored
+	SuspendEvent(ctx context.Context, in *SuspendEventRequest, opts ...grpc.CallOption) (*SuspendEventResponse, error)
+	// ResumeEvent reopens the markets of a suspended sports event. Markets suspended on their own stay suspended
+	ResumeEvent(ctx context.Context, in *ResumeEventRequest, opts ...grpc.CallOption) (*ResumeEventResponse, error)
+	// SuspendMarket suspends a single market of a sports event
+	SuspendMarket(ctx context.Context, in *SuspendMarketRequest, opts ...grpc.CallOption) (*SuspendMarketResponse, error)
+	// ResumeMarket reopens a suspended market of a sports event
+	ResumeMarket(ctx context.Context, in *ResumeMarketRequest, opts ...grpc.CallOption) (*ResumeMarketResponse, error)
+	// ListOutrights returns the outright (futures) markets of competitions
+	ListOutrights(ctx context.Context, in *ListOutrightsRequest, opts ...grpc.CallOption) (*ListOutrightsResponse, error)
+	// GetOutright returns an outright market with its selections and prices
+	GetOutright(ctx context.Context, in *GetOutrightRequest, opts ...grpc.CallOption) (*GetOutrightResponse, error)
+	// SettleOutright settles a closed outright market with its winning selection
+	SettleOutright(ctx context.Context, in *SettleOutrightRequest, opts ...grpc.CallOption) (*SettleOutrightResponse, error)
 }
 
 type sportsClient struct {
@@ -50,6 +80,164 @@ func (c *sportsClient) GetSportById(ctx context.Context, in *GetSportRequest, op
 	return out, nil
 }
 
+func (c *sportsClient) GetEventResult(ctx context.Context, in *GetEventResultRequest, opts ...grpc.CallOption) (*GetEventResultResponse, error) {
+	out := new(GetEventResultResponse)
+	err := c.cc.Invoke(ctx, "/sports.Sports/GetEventResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) RecordEventResult(ctx context.Context, in *RecordEventResultRequest, opts ...grpc.CallOption) (*RecordEventResultResponse, error) {
+	out := new(RecordEventResultResponse)
+	err := c.cc.Invoke(ctx, "/sports.Sports/RecordEventResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) AddIncident(ctx context.Context, in *AddIncidentRequest, opts ...grpc.CallOption) (*AddIncidentResponse, error) {
+	out := new(AddIncidentResponse)
+	err := c.cc.Invoke(ctx, "/sports.Sports/AddIncident", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) ListIncidents(ctx context.Context, in *ListIncidentsRequest, opts ...grpc.CallOption) (*ListIncidentsResponse, error) {
+	out := new(ListIncidentsResponse)
+	err := c.cc.Invoke(ctx, "/sports.Sports/ListIncidents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) WatchEvent(ctx context.Context, in *WatchEventRequest, opts ...grpc.CallOption) (Sports_WatchEventClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Sports_ServiceDesc.Streams[0], "/sports.Sports/WatchEvent", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sportsWatchEventClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Sports_WatchEventClient interface {
+	Recv() (*EventUpdate, error)
+	grpc.ClientStream
+}
+
+type sportsWatchEventClient struct {
+	grpc.ClientStream
+}
+
+func (x *sportsWatchEventClient) Recv() (*EventUpdate, error) {
+	m := new(EventUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *sportsClient) GetStandings(ctx context.Context, in *GetStandingsRequest, opts ...grpc.CallOption) (*GetStandingsResponse, error) {
+	out := new(GetStandingsResponse)
+	err := c.cc.Invoke(ctx, "/sports.Sports/GetStandings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) GetHeadToHead(ctx context.Context, in *GetHeadToHeadRequest, opts ...grpc.CallOption) (*GetHeadToHeadResponse, error) {
+	out := new(GetHeadToHeadResponse)
+	err := c.cc.Invoke(ctx, "/sports.Sports/GetHeadToHead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) ListMarkets(ctx context.Context, in *ListMarketsRequest, opts ...grpc.CallOption) (*ListMarketsResponse, error) {
+	out := new(ListMarketsResponse)
+	err := c.cc.Invoke(ctx, "/sports.Sports/ListMarkets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) SuspendEvent(ctx context.Context, in *SuspendEventRequest, opts ...grpc.CallOption) (*SuspendEventResponse, error) {
+	out := new(SuspendEventResponse)
+	err := c.cc.Invoke(ctx, "/sports.Sports/SuspendEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) ResumeEvent(ctx context.Context, in *ResumeEventRequest, opts ...grpc.CallOption) (*ResumeEventResponse, error) {
+	out := new(ResumeEventResponse)
+	err := c.cc.Invoke(ctx, "/sports.Sports/ResumeEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) SuspendMarket(ctx context.Context, in *SuspendMarketRequest, opts ...grpc.CallOption) (*SuspendMarketResponse, error) {
+	out := new(SuspendMarketResponse)
+	err := c.cc.Invoke(ctx, "/sports.Sports/SuspendMarket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) ResumeMarket(ctx context.Context, in *ResumeMarketRequest, opts ...grpc.CallOption) (*ResumeMarketResponse, error) {
+	out := new(ResumeMarketResponse)
+	err := c.cc.Invoke(ctx, "/sports.Sports/ResumeMarket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) ListOutrights(ctx context.Context, in *ListOutrightsRequest, opts ...grpc.CallOption) (*ListOutrightsResponse, error) {
+	out := new(ListOutrightsResponse)
+	err := c.cc.Invoke(ctx, "/sports.Sports/ListOutrights", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) GetOutright(ctx context.Context, in *GetOutrightRequest, opts ...grpc.CallOption) (*GetOutrightResponse, error) {
+	out := new(GetOutrightResponse)
+	err := c.cc.Invoke(ctx, "/sports.Sports/GetOutright", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) SettleOutright(ctx context.Context, in *SettleOutrightRequest, opts ...grpc.CallOption) (*SettleOutrightResponse, error) {
+	out := new(SettleOutrightResponse)
+	err := c.cc.Invoke(ctx, "/sports.Sports/SettleOutright", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SportsServer is the server API for Sports service.
 // All implementations must embed UnimplementedSportsServer
 // for forward compatibility
@@ -58,6 +246,36 @@ type SportsServer interface {
 	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
 	// Get race details by id
 	GetSportById(context.Context, *GetSportRequest) (*GetSportResponse, error)
+	// GetEventResult returns the latest result of a sports event along with its revision history
+	GetEventResult(context.Context, *GetEventResultRequest) (*GetEventResultResponse, error)
+	// RecordEventResult records a new result revision for a sports event. Use this to enter and correct results
+	RecordEventResult(context.Context, *RecordEventResultRequest) (*RecordEventResultResponse, error)
+	// AddIncident adds an incident (goal, card, substitution etc.) to the timeline of a sports event
+	AddIncident(context.Context, *AddIncidentRequest) (*AddIncidentResponse, error)
+	// ListIncidents returns the timeline of a sports event. Use since_sequence to only fetch the incidents added since the last call
+	ListIncidents(context.Context, *ListIncidentsRequest) (*ListIncidentsResponse, error)
+	// WatchEvent streams the live updates (incidents and results) of a sports event
+	WatchEvent(*WatchEventRequest, Sports_WatchEventServer) error
+	// GetStandings returns the ladder of a competition season computed from the final results
+	GetStandings(context.Context, *GetStandingsRequest) (*GetStandingsResponse, error)
+	// GetHeadToHead returns the recent meetings between two teams and a summary of their head to head record
+	GetHeadToHead(context.Context, *GetHeadToHeadRequest) (*GetHeadToHeadResponse, error)
+	// ListMarkets returns the markets of a sports event along with their suspended state
+	ListMarkets(context.Context, *ListMarketsRequest) (*ListMarketsResponse, error)
+	// SuspendEvent suspends all the markets of a sports event at once, e.g. when a goal is scored
+	SuspendEvent(context.Context, *SuspendEventRequest) (*SuspendEventResponse, error)
+	// ResumeEvent reopens the markets of a suspended sports event. Markets suspended on their own stay suspended
+	ResumeEvent(context.Context, *ResumeEventRequest) (*ResumeEventResponse, error)
+	// SuspendMarket suspends a single market of a sports event
+	SuspendMarket(context.Context, *SuspendMarketRequest) (*SuspendMarketResponse, error)
+	// ResumeMarket reopens a suspended market of a sports event
+	ResumeMarket(context.Context, *ResumeMarketRequest) (*ResumeMarketResponse, error)
+	// ListOutrights returns the outright (futures) markets of competitions
+	ListOutrights(context.Context, *ListOutrightsRequest) (*ListOutrightsResponse, error)
+	// GetOutright returns an outright market with its selections and prices
+	GetOutright(context.Context, *GetOutrightRequest) (*GetOutrightResponse, error)
+	// SettleOutright settles a closed outright market with its winning selection
+	SettleOutright(context.Context, *SettleOutrightRequest) (*SettleOutrightResponse, error)
 	mustEmbedUnimplementedSportsServer()
 }
 
@@ -71,6 +289,51 @@ func (UnimplementedSportsServer) ListEvents(context.Context, *ListEventsRequest)
 func (UnimplementedSportsServer) GetSportById(context.Context, *GetSportRequest) (*GetSportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSportById not implemented")
 }
+func (UnimplementedSportsServer) GetEventResult(context.Context, *GetEventResultRequest) (*GetEventResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventResult not implemented")
+}
+func (UnimplementedSportsServer) RecordEventResult(context.Context, *RecordEventResultRequest) (*RecordEventResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordEventResult not implemented")
+}
+func (UnimplementedSportsServer) AddIncident(context.Context, *AddIncidentRequest) (*AddIncidentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddIncident not implemented")
+}
+func (UnimplementedSportsServer) ListIncidents(context.Context, *ListIncidentsRequest) (*ListIncidentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIncidents not implemented")
+}
+func (UnimplementedSportsServer) WatchEvent(*WatchEventRequest, Sports_WatchEventServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEvent not implemented")
+}
+func (UnimplementedSportsServer) GetStandings(context.Context, *GetStandingsRequest) (*GetStandingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStandings not implemented")
+}
+func (UnimplementedSportsServer) GetHeadToHead(context.Context, *GetHeadToHeadRequest) (*GetHeadToHeadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHeadToHead not implemented")
+}
+func (UnimplementedSportsServer) ListMarkets(context.Context, *ListMarketsRequest) (*ListMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMarkets not implemented")
+}
+func (UnimplementedSportsServer) SuspendEvent(context.Context, *SuspendEventRequest) (*SuspendEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendEvent not implemented")
+}
+func (UnimplementedSportsServer) ResumeEvent(context.Context, *ResumeEventRequest) (*ResumeEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeEvent not implemented")
+}
+func (UnimplementedSportsServer) SuspendMarket(context.Context, *SuspendMarketRequest) (*SuspendMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendMarket not implemented")
+}
+func (UnimplementedSportsServer) ResumeMarket(context.Context, *ResumeMarketRequest) (*ResumeMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeMarket not implemented")
+}
+func (UnimplementedSportsServer) ListOutrights(context.Context, *ListOutrightsRequest) (*ListOutrightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOutrights not implemented")
+}
+func (UnimplementedSportsServer) GetOutright(context.Context, *GetOutrightRequest) (*GetOutrightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOutright not implemented")
+}
+func (UnimplementedSportsServer) SettleOutright(context.Context, *SettleOutrightRequest) (*SettleOutrightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleOutright not implemented")
+}
 func (UnimplementedSportsServer) mustEmbedUnimplementedSportsServer() {}
 
 // UnsafeSportsServer may be embedded to opt out of forward compatibility for this service.
@@ -120,6 +383,279 @@ func _Sports_GetSportById_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Sports_GetEventResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).GetEventResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/GetEventResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).GetEventResult(ctx, req.(*GetEventResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_RecordEventResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordEventResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).RecordEventResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/RecordEventResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).RecordEventResult(ctx, req.(*RecordEventResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_AddIncident_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddIncidentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).AddIncident(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/AddIncident",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).AddIncident(ctx, req.(*AddIncidentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_ListIncidents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIncidentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).ListIncidents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/ListIncidents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).ListIncidents(ctx, req.(*ListIncidentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_WatchEvent_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEventRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SportsServer).WatchEvent(m, &sportsWatchEventServer{stream})
+}
+
+type Sports_WatchEventServer interface {
+	Send(*EventUpdate) error
+	grpc.ServerStream
+}
+
+type sportsWatchEventServer struct {
+	grpc.ServerStream
+}
+
+func (x *sportsWatchEventServer) Send(m *EventUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Sports_GetStandings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStandingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).GetStandings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/GetStandings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).GetStandings(ctx, req.(*GetStandingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_GetHeadToHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHeadToHeadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).GetHeadToHead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/GetHeadToHead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).GetHeadToHead(ctx, req.(*GetHeadToHeadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_ListMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMarketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).ListMarkets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/ListMarkets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).ListMarkets(ctx, req.(*ListMarketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_SuspendEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).SuspendEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/SuspendEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).SuspendEvent(ctx, req.(*SuspendEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_ResumeEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).ResumeEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/ResumeEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).ResumeEvent(ctx, req.(*ResumeEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_SuspendMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendMarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).SuspendMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/SuspendMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).SuspendMarket(ctx, req.(*SuspendMarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_ResumeMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeMarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).ResumeMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/ResumeMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).ResumeMarket(ctx, req.(*ResumeMarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_ListOutrights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOutrightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).ListOutrights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/ListOutrights",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).ListOutrights(ctx, req.(*ListOutrightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_GetOutright_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOutrightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).GetOutright(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/GetOutright",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).GetOutright(ctx, req.(*GetOutrightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_SettleOutright_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettleOutrightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).SettleOutright(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/SettleOutright",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).SettleOutright(ctx, req.(*SettleOutrightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Sports_ServiceDesc is the grpc.ServiceDesc for Sports service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -135,7 +671,69 @@ var Sports_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSportById",
 			Handler:    _Sports_GetSportById_Handler,
 		},
+		{
+			MethodName: "GetEventResult",
+			Handler:    _Sports_GetEventResult_Handler,
+		},
+		{
+			MethodName: "RecordEventResult",
+			Handler:    _Sports_RecordEventResult_Handler,
+		},
+		{
+			MethodName: "AddIncident",
+			Handler:    _Sports_AddIncident_Handler,
+		},
+		{
+			MethodName: "ListIncidents",
+			Handler:    _Sports_ListIncidents_Handler,
+		},
+		{
+			MethodName: "GetStandings",
+			Handler:    _Sports_GetStandings_Handler,
+		},
+		{
+			MethodName: "GetHeadToHead",
+			Handler:    _Sports_GetHeadToHead_Handler,
+		},
+		{
+			MethodName: "ListMarkets",
+			Handler:    _Sports_ListMarkets_Handler,
+		},
+		{
+			MethodName: "SuspendEvent",
+			Handler:    _Sports_SuspendEvent_Handler,
+		},
+		{
+			MethodName: "ResumeEvent",
+			Handler:    _Sports_ResumeEvent_Handler,
+		},
+		{
+			MethodName: "SuspendMarket",
+			Handler:    _Sports_SuspendMarket_Handler,
+		},
+		{
+			MethodName: "ResumeMarket",
+			Handler:    _Sports_ResumeMarket_Handler,
+		},
+		{
+			MethodName: "ListOutrights",
+			Handler:    _Sports_ListOutrights_Handler,
+		},
+		{
+			MethodName: "GetOutright",
+			Handler:    _Sports_GetOutright_Handler,
+		},
+		{
+			MethodName: "SettleOutright",
+			Handler:    _Sports_SettleOutright_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchEvent",
+			Handler:       _Sports_WatchEvent_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "sports/sports.proto",
 }
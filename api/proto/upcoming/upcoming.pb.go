@@ -0,0 +1,655 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.3
+// source: upcoming/upcoming.proto
+
+package upcoming
+
+import (
+	racing "git.neds.sh/matty/entain/api/proto/racing"
+	sports "git.neds.sh/matty/entain/api/proto/sports"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The categories of the feed, one per backend
+type Category int32
+
+const (
+	// Races from the racing service
+	Category_RACING Category = 0
+	// Sports events from the sports service
+	Category_SPORTS Category = 1
+)
+
+// Enum value maps for Category.
+var (
+	Category_name = map[int32]string{
+		0: "RACING",
+		1: "SPORTS",
+	}
+	Category_value = map[string]int32{
+		"RACING": 0,
+		"SPORTS": 1,
+	}
+)
+
+func (x Category) Enum() *Category {
+	p := new(Category)
+	*p = x
+	return p
+}
+
+func (x Category) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Category) Descriptor() protoreflect.EnumDescriptor {
+	return file_upcoming_upcoming_proto_enumTypes[0].Descriptor()
+}
+
+func (Category) Type() protoreflect.EnumType {
+	return &file_upcoming_upcoming_proto_enumTypes[0]
+}
+
+func (x Category) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Category.Descriptor instead.
+func (Category) EnumDescriptor() ([]byte, []int) {
+	return file_upcoming_upcoming_proto_rawDescGZIP(), []int{0}
+}
+
+// Request for ListUpcoming call
+type ListUpcomingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Maximum number of items to return, 10 by default and at most 100
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Only return the items of these categories. All the categories are returned without it
+	Categories []Category `protobuf:"varint,2,rep,packed,name=categories,proto3,enum=upcoming.Category" json:"categories,omitempty"`
+	// Only return the races and sports events taking place in this country (ISO 3166-1 alpha-2 code, e.g. AU)
+	Country string `protobuf:"bytes,3,opt,name=country,proto3" json:"country,omitempty"`
+}
+
+func (x *ListUpcomingRequest) Reset() {
+	*x = ListUpcomingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_upcoming_upcoming_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUpcomingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUpcomingRequest) ProtoMessage() {}
+
+func (x *ListUpcomingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_upcoming_upcoming_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUpcomingRequest.ProtoReflect.Descriptor instead.
+func (*ListUpcomingRequest) Descriptor() ([]byte, []int) {
+	return file_upcoming_upcoming_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListUpcomingRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListUpcomingRequest) GetCategories() []Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *ListUpcomingRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+// Response to ListUpcoming call
+type ListUpcomingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The races and sports events that haven't started yet, the next one first
+	Items []*UpcomingItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// The categories missing from the feed because their backend couldn't be reached
+	Unavailable []*UnavailableCategory `protobuf:"bytes,2,rep,name=unavailable,proto3" json:"unavailable,omitempty"`
+	// Whether some categories are missing from the feed
+	Partial bool `protobuf:"varint,3,opt,name=partial,proto3" json:"partial,omitempty"`
+}
+
+func (x *ListUpcomingResponse) Reset() {
+	*x = ListUpcomingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_upcoming_upcoming_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUpcomingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUpcomingResponse) ProtoMessage() {}
+
+func (x *ListUpcomingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_upcoming_upcoming_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUpcomingResponse.ProtoReflect.Descriptor instead.
+func (*ListUpcomingResponse) Descriptor() ([]byte, []int) {
+	return file_upcoming_upcoming_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListUpcomingResponse) GetItems() []*UpcomingItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListUpcomingResponse) GetUnavailable() []*UnavailableCategory {
+	if x != nil {
+		return x.Unavailable
+	}
+	return nil
+}
+
+func (x *ListUpcomingResponse) GetPartial() bool {
+	if x != nil {
+		return x.Partial
+	}
+	return false
+}
+
+// An item of the feed, either a race or a sports event
+type UpcomingItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Tells whether the item is a race or a sports event
+	Category Category `protobuf:"varint,1,opt,name=category,proto3,enum=upcoming.Category" json:"category,omitempty"`
+	// ID of the race or the sports event
+	Id int64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Name of the race or the sports event
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Time the race or the sports event is advertised to start
+	AdvertisedStartTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=advertised_start_time,json=advertisedStartTime,proto3" json:"advertised_start_time,omitempty"`
+	// The race or the sports event, depending on the category
+	//
+	// Types that are assignable to Event:
+	//	*UpcomingItem_Race
+	//	*UpcomingItem_Sport
+	Event isUpcomingItem_Event `protobuf_oneof:"event"`
+}
+
+func (x *UpcomingItem) Reset() {
+	*x = UpcomingItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_upcoming_upcoming_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpcomingItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpcomingItem) ProtoMessage() {}
+
+func (x *UpcomingItem) ProtoReflect() protoreflect.Message {
+	mi := &file_upcoming_upcoming_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpcomingItem.ProtoReflect.Descriptor instead.
+func (*UpcomingItem) Descriptor() ([]byte, []int) {
+	return file_upcoming_upcoming_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpcomingItem) GetCategory() Category {
+	if x != nil {
+		return x.Category
+	}
+	return Category_RACING
+}
+
+func (x *UpcomingItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpcomingItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpcomingItem) GetAdvertisedStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AdvertisedStartTime
+	}
+	return nil
+}
+
+func (m *UpcomingItem) GetEvent() isUpcomingItem_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *UpcomingItem) GetRace() *racing.Race {
+	if x, ok := x.GetEvent().(*UpcomingItem_Race); ok {
+		return x.Race
+	}
+	return nil
+}
+
+func (x *UpcomingItem) GetSport() *sports.Sport {
+	if x, ok := x.GetEvent().(*UpcomingItem_Sport); ok {
+		return x.Sport
+	}
+	return nil
+}
+
+type isUpcomingItem_Event interface {
+	isUpcomingItem_Event()
+}
+
+type UpcomingItem_Race struct {
+	Race *racing.Race `protobuf:"bytes,5,opt,name=race,proto3,oneof"`
+}
+
+type UpcomingItem_Sport struct {
+	Sport *sports.Sport `protobuf:"bytes,6,opt,name=sport,proto3,oneof"`
+}
+
+func (*UpcomingItem_Race) isUpcomingItem_Event() {}
+
+func (*UpcomingItem_Sport) isUpcomingItem_Event() {}
+
+// A category missing from the feed
+type UnavailableCategory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Category Category `protobuf:"varint,1,opt,name=category,proto3,enum=upcoming.Category" json:"category,omitempty"`
+	// Why the category is missing, e.g. the error of its backend
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *UnavailableCategory) Reset() {
+	*x = UnavailableCategory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_upcoming_upcoming_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnavailableCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnavailableCategory) ProtoMessage() {}
+
+func (x *UnavailableCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_upcoming_upcoming_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnavailableCategory.ProtoReflect.Descriptor instead.
+func (*UnavailableCategory) Descriptor() ([]byte, []int) {
+	return file_upcoming_upcoming_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UnavailableCategory) GetCategory() Category {
+	if x != nil {
+		return x.Category
+	}
+	return Category_RACING
+}
+
+func (x *UnavailableCategory) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_upcoming_upcoming_proto protoreflect.FileDescriptor
+
+var file_upcoming_upcoming_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x2f, 0x75, 0x70, 0x63, 0x6f, 0x6d,
+	0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x75, 0x70, 0x63, 0x6f, 0x6d,
+	0x69, 0x6e, 0x67, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f,
+	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x13, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x2f, 0x72, 0x61, 0x63, 0x69, 0x6e,
+	0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2f,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x79, 0x0a, 0x13,
+	0x4c, 0x69, 0x73, 0x74, 0x55, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x32, 0x0a, 0x0a, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x12, 0x2e,
+	0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x9f, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74,
+	0x55, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2c, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x63, 0x6f, 0x6d,
+	0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x3f,
+	0x0a, 0x0b, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x55,
+	0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x22, 0x86, 0x02, 0x0a, 0x0c, 0x55, 0x70,
+	0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x2e, 0x0a, 0x08, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x75,
+	0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4e,
+	0x0a, 0x15, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13, 0x61, 0x64, 0x76, 0x65, 0x72,
+	0x74, 0x69, 0x73, 0x65, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x22,
+	0x0a, 0x04, 0x72, 0x61, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72,
+	0x61, 0x63, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x61, 0x63, 0x65, 0x48, 0x00, 0x52, 0x04, 0x72, 0x61,
+	0x63, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x53, 0x70, 0x6f, 0x72, 0x74,
+	0x48, 0x00, 0x52, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x22, 0x5b, 0x0a, 0x13, 0x55, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x08, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x75, 0x70,
+	0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52,
+	0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2a,
+	0x22, 0x0a, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x0a, 0x0a, 0x06, 0x52,
+	0x41, 0x43, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x50, 0x4f, 0x52, 0x54,
+	0x53, 0x10, 0x01, 0x32, 0x9a, 0x0a, 0x0a, 0x08, 0x55, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67,
+	0x12, 0x8d, 0x0a, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e,
+	0x67, 0x12, 0x1d, 0x2e, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x55, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x55, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xbd, 0x09, 0x92, 0x41, 0xa5, 0x09, 0x4a, 0xa2, 0x09, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12,
+	0x9a, 0x09, 0x22, 0x97, 0x09, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x82, 0x09, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x22, 0x3a, 0x5b, 0x7b, 0x22, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0x3a,
+	0x22, 0x52, 0x41, 0x43, 0x49, 0x4e, 0x47, 0x22, 0x2c, 0x22, 0x69, 0x64, 0x22, 0x3a, 0x22, 0x36,
+	0x37, 0x22, 0x2c, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x22, 0x4e, 0x6f, 0x72, 0x74, 0x68,
+	0x20, 0x43, 0x61, 0x72, 0x6f, 0x6c, 0x69, 0x6e, 0x61, 0x20, 0x72, 0x61, 0x62, 0x62, 0x69, 0x74,
+	0x73, 0x22, 0x2c, 0x22, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x64, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x3a, 0x22, 0x32, 0x30, 0x32, 0x36, 0x2d, 0x31,
+	0x30, 0x2d, 0x32, 0x31, 0x54, 0x30, 0x30, 0x3a, 0x34, 0x34, 0x3a, 0x30, 0x35, 0x5a, 0x22, 0x2c,
+	0x22, 0x72, 0x61, 0x63, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x64, 0x22, 0x3a, 0x22, 0x36, 0x37,
+	0x22, 0x2c, 0x22, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0x3a, 0x22, 0x35,
+	0x22, 0x2c, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x22, 0x4e, 0x6f, 0x72, 0x74, 0x68, 0x20,
+	0x43, 0x61, 0x72, 0x6f, 0x6c, 0x69, 0x6e, 0x61, 0x20, 0x72, 0x61, 0x62, 0x62, 0x69, 0x74, 0x73,
+	0x22, 0x2c, 0x22, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x3a, 0x22, 0x33, 0x22, 0x2c, 0x22,
+	0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x22, 0x3a, 0x74, 0x72, 0x75, 0x65, 0x2c, 0x22, 0x61,
+	0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0x3a, 0x22, 0x32, 0x30, 0x32, 0x36, 0x2d, 0x31, 0x30, 0x2d, 0x32, 0x31, 0x54,
+	0x30, 0x30, 0x3a, 0x34, 0x34, 0x3a, 0x30, 0x35, 0x5a, 0x22, 0x2c, 0x22, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x3a, 0x22, 0x4f, 0x50, 0x45, 0x4e, 0x22, 0x2c, 0x22, 0x76, 0x65, 0x6e, 0x75,
+	0x65, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x64, 0x22, 0x3a, 0x22, 0x35, 0x22, 0x2c, 0x22, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x3a, 0x22, 0x43, 0x61, 0x75, 0x6c, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x2c,
+	0x22, 0x63, 0x69, 0x74, 0x79, 0x22, 0x3a, 0x22, 0x4d, 0x65, 0x6c, 0x62, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x22, 0x2c, 0x22, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x3a, 0x22, 0x41, 0x55,
+	0x22, 0x2c, 0x22, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x22, 0x3a, 0x2d, 0x33, 0x37,
+	0x2e, 0x38, 0x38, 0x31, 0x35, 0x2c, 0x22, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x22, 0x3a, 0x31, 0x34, 0x35, 0x2e, 0x30, 0x33, 0x39, 0x34, 0x2c, 0x22, 0x74, 0x69, 0x6d, 0x65,
+	0x7a, 0x6f, 0x6e, 0x65, 0x22, 0x3a, 0x22, 0x41, 0x75, 0x73, 0x74, 0x72, 0x61, 0x6c, 0x69, 0x61,
+	0x2f, 0x4d, 0x65, 0x6c, 0x62, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x22, 0x7d, 0x2c, 0x22, 0x61, 0x64,
+	0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x6f, 0x63,
+	0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x3a, 0x22, 0x32, 0x30, 0x32, 0x36, 0x2d, 0x31, 0x30,
+	0x2d, 0x32, 0x31, 0x54, 0x31, 0x31, 0x3a, 0x34, 0x34, 0x3a, 0x30, 0x35, 0x2b, 0x31, 0x31, 0x3a,
+	0x30, 0x30, 0x22, 0x7d, 0x7d, 0x2c, 0x7b, 0x22, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x22, 0x3a, 0x22, 0x53, 0x50, 0x4f, 0x52, 0x54, 0x53, 0x22, 0x2c, 0x22, 0x69, 0x64, 0x22, 0x3a,
+	0x22, 0x37, 0x22, 0x2c, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x22, 0x4d, 0x69, 0x6e, 0x6e,
+	0x65, 0x73, 0x6f, 0x74, 0x61, 0x20, 0x73, 0x70, 0x69, 0x72, 0x69, 0x74, 0x73, 0x22, 0x2c, 0x22,
+	0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0x3a, 0x22, 0x32, 0x30, 0x32, 0x36, 0x2d, 0x31, 0x30, 0x2d, 0x32, 0x31,
+	0x54, 0x31, 0x33, 0x3a, 0x32, 0x35, 0x3a, 0x32, 0x39, 0x5a, 0x22, 0x2c, 0x22, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x64, 0x22, 0x3a, 0x22, 0x37, 0x22, 0x2c, 0x22, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0x3a, 0x22, 0x35, 0x22, 0x2c, 0x22, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x3a, 0x22, 0x4d, 0x69, 0x6e, 0x6e, 0x65, 0x73, 0x6f, 0x74, 0x61, 0x20,
+	0x73, 0x70, 0x69, 0x72, 0x69, 0x74, 0x73, 0x22, 0x2c, 0x22, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x22, 0x3a, 0x22, 0x38, 0x22, 0x2c, 0x22, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x22, 0x3a,
+	0x74, 0x72, 0x75, 0x65, 0x2c, 0x22, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x64,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x3a, 0x22, 0x32, 0x30, 0x32, 0x36,
+	0x2d, 0x31, 0x30, 0x2d, 0x32, 0x31, 0x54, 0x31, 0x33, 0x3a, 0x32, 0x35, 0x3a, 0x32, 0x39, 0x5a,
+	0x22, 0x2c, 0x22, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3a, 0x22, 0x4f, 0x50, 0x45, 0x4e,
+	0x22, 0x2c, 0x22, 0x62, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0x3a, 0x22, 0x32, 0x30, 0x32, 0x36, 0x2d, 0x31, 0x30, 0x2d, 0x32,
+	0x34, 0x54, 0x31, 0x39, 0x3a, 0x35, 0x31, 0x3a, 0x33, 0x31, 0x5a, 0x22, 0x2c, 0x22, 0x68, 0x6f,
+	0x6d, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x22, 0x3a, 0x22, 0x4b, 0x65, 0x6e, 0x74, 0x75, 0x63, 0x6b,
+	0x79, 0x20, 0x76, 0x61, 0x6d, 0x70, 0x69, 0x72, 0x65, 0x73, 0x22, 0x2c, 0x22, 0x61, 0x77, 0x61,
+	0x79, 0x54, 0x65, 0x61, 0x6d, 0x22, 0x3a, 0x22, 0x41, 0x72, 0x6b, 0x61, 0x6e, 0x73, 0x61, 0x73,
+	0x20, 0x70, 0x65, 0x6f, 0x70, 0x6c, 0x65, 0x22, 0x2c, 0x22, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x74,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x3a, 0x22, 0x30, 0x22, 0x2c, 0x22, 0x63, 0x6f,
+	0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x22, 0x2c, 0x22, 0x73,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x32, 0x30, 0x32, 0x36, 0x22, 0x2c, 0x22, 0x76,
+	0x65, 0x6e, 0x75, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x64, 0x22, 0x3a, 0x22, 0x31, 0x38, 0x22,
+	0x2c, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x22, 0x4f, 0x6c, 0x64, 0x20, 0x54, 0x72, 0x61,
+	0x66, 0x66, 0x6f, 0x72, 0x64, 0x22, 0x2c, 0x22, 0x63, 0x69, 0x74, 0x79, 0x22, 0x3a, 0x22, 0x4d,
+	0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x74, 0x65, 0x72, 0x22, 0x2c, 0x22, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x72, 0x79, 0x22, 0x3a, 0x22, 0x47, 0x42, 0x22, 0x2c, 0x22, 0x6c, 0x61, 0x74, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x22, 0x3a, 0x35, 0x33, 0x2e, 0x34, 0x36, 0x33, 0x31, 0x2c, 0x22, 0x6c, 0x6f,
+	0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x22, 0x3a, 0x2d, 0x32, 0x2e, 0x32, 0x39, 0x31, 0x33,
+	0x2c, 0x22, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x22, 0x3a, 0x22, 0x45, 0x75, 0x72,
+	0x6f, 0x70, 0x65, 0x2f, 0x4c, 0x6f, 0x6e, 0x64, 0x6f, 0x6e, 0x22, 0x7d, 0x2c, 0x22, 0x61, 0x64,
+	0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x6f, 0x63,
+	0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x3a, 0x22, 0x32, 0x30, 0x32, 0x36, 0x2d, 0x31, 0x30,
+	0x2d, 0x32, 0x31, 0x54, 0x31, 0x34, 0x3a, 0x32, 0x35, 0x3a, 0x32, 0x39, 0x2b, 0x30, 0x31, 0x3a,
+	0x30, 0x30, 0x22, 0x2c, 0x22, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x22, 0x3a,
+	0x66, 0x61, 0x6c, 0x73, 0x65, 0x2c, 0x22, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0x3a, 0x6e, 0x75, 0x6c, 0x6c, 0x7d, 0x7d, 0x5d, 0x2c, 0x22, 0x75, 0x6e, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x61, 0x6c, 0x22, 0x3a, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x7d, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67,
+	0x42, 0x59, 0x5a, 0x09, 0x2f, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x92, 0x41, 0x4b,
+	0x12, 0x49, 0x0a, 0x0a, 0x45, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x20, 0x41, 0x50, 0x49, 0x12, 0x36,
+	0x52, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x20, 0x41, 0x50, 0x49, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x45, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x20, 0x41, 0x50, 0x49, 0x20, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x32, 0x03, 0x31, 0x2e, 0x30, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_upcoming_upcoming_proto_rawDescOnce sync.Once
+	file_upcoming_upcoming_proto_rawDescData = file_upcoming_upcoming_proto_rawDesc
+)
+
+func file_upcoming_upcoming_proto_rawDescGZIP() []byte {
+	file_upcoming_upcoming_proto_rawDescOnce.Do(func() {
+		file_upcoming_upcoming_proto_rawDescData = protoimpl.X.CompressGZIP(file_upcoming_upcoming_proto_rawDescData)
+	})
+	return file_upcoming_upcoming_proto_rawDescData
+}
+
+var file_upcoming_upcoming_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_upcoming_upcoming_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_upcoming_upcoming_proto_goTypes = []interface{}{
+	(Category)(0),                 // 0: upcoming.Category
+	(*ListUpcomingRequest)(nil),   // 1: upcoming.ListUpcomingRequest
+	(*ListUpcomingResponse)(nil),  // 2: upcoming.ListUpcomingResponse
+	(*UpcomingItem)(nil),          // 3: upcoming.UpcomingItem
+	(*UnavailableCategory)(nil),   // 4: upcoming.UnavailableCategory
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+	(*racing.Race)(nil),           // 6: racing.Race
+	(*sports.Sport)(nil),          // 7: sports.Sport
+}
+var file_upcoming_upcoming_proto_depIdxs = []int32{
+	0, // 0: upcoming.ListUpcomingRequest.categories:type_name -> upcoming.Category
+	3, // 1: upcoming.ListUpcomingResponse.items:type_name -> upcoming.UpcomingItem
+	4, // 2: upcoming.ListUpcomingResponse.unavailable:type_name -> upcoming.UnavailableCategory
+	0, // 3: upcoming.UpcomingItem.category:type_name -> upcoming.Category
+	5, // 4: upcoming.UpcomingItem.advertised_start_time:type_name -> google.protobuf.Timestamp
+	6, // 5: upcoming.UpcomingItem.race:type_name -> racing.Race
+	7, // 6: upcoming.UpcomingItem.sport:type_name -> sports.Sport
+	0, // 7: upcoming.UnavailableCategory.category:type_name -> upcoming.Category
+	1, // 8: upcoming.Upcoming.ListUpcoming:input_type -> upcoming.ListUpcomingRequest
+	2, // 9: upcoming.Upcoming.ListUpcoming:output_type -> upcoming.ListUpcomingResponse
+	9, // [9:10] is the sub-list for method output_type
+	8, // [8:9] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_upcoming_upcoming_proto_init() }
+func file_upcoming_upcoming_proto_init() {
+	if File_upcoming_upcoming_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_upcoming_upcoming_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUpcomingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_upcoming_upcoming_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUpcomingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_upcoming_upcoming_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpcomingItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_upcoming_upcoming_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnavailableCategory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_upcoming_upcoming_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*UpcomingItem_Race)(nil),
+		(*UpcomingItem_Sport)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_upcoming_upcoming_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_upcoming_upcoming_proto_goTypes,
+		DependencyIndexes: file_upcoming_upcoming_proto_depIdxs,
+		EnumInfos:         file_upcoming_upcoming_proto_enumTypes,
+		MessageInfos:      file_upcoming_upcoming_proto_msgTypes,
+	}.Build()
+	File_upcoming_upcoming_proto = out.File
+	file_upcoming_upcoming_proto_rawDesc = nil
+	file_upcoming_upcoming_proto_goTypes = nil
+	file_upcoming_upcoming_proto_depIdxs = nil
+}
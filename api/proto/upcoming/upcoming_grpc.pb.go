@@ -0,0 +1,105 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package upcoming
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// UpcomingClient is the client API for Upcoming service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UpcomingClient interface {
+	// ListUpcoming returns the next races and sports events to start, merged by their advertised start time.
+	// The feed is partial when a backend is down, see ListUpcomingResponse.unavailable
+	ListUpcoming(ctx context.Context, in *ListUpcomingRequest, opts ...grpc.CallOption) (*ListUpcomingResponse, error)
+}
+
+type upcomingClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUpcomingClient(cc grpc.ClientConnInterface) UpcomingClient {
+	return &upcomingClient{cc}
+}
+
+func (c *upcomingClient) ListUpcoming(ctx context.Context, in *ListUpcomingRequest, opts ...grpc.CallOption) (*ListUpcomingResponse, error) {
+	out := new(ListUpcomingResponse)
+	err := c.cc.Invoke(ctx, "/upcoming.Upcoming/ListUpcoming", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UpcomingServer is the server API for Upcoming service.
+// All implementations must embed UnimplementedUpcomingServer
+// for forward compatibility
+type UpcomingServer interface {
+	// ListUpcoming returns the next races and sports events to start, merged by their advertised start time.
+	// The feed is partial when a backend is down, see ListUpcomingResponse.unavailable
+	ListUpcoming(context.Context, *ListUpcomingRequest) (*ListUpcomingResponse, error)
+	mustEmbedUnimplementedUpcomingServer()
+}
+
+// UnimplementedUpcomingServer must be embedded to have forward compatible implementations.
+type UnimplementedUpcomingServer struct {
+}
+
+func (UnimplementedUpcomingServer) ListUpcoming(context.Context, *ListUpcomingRequest) (*ListUpcomingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUpcoming not implemented")
+}
+func (UnimplementedUpcomingServer) mustEmbedUnimplementedUpcomingServer() {}
+
+// UnsafeUpcomingServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UpcomingServer will
+// result in compilation errors.
+type UnsafeUpcomingServer interface {
+	mustEmbedUnimplementedUpcomingServer()
+}
+
+func RegisterUpcomingServer(s grpc.ServiceRegistrar, srv UpcomingServer) {
+	s.RegisterService(&Upcoming_ServiceDesc, srv)
+}
+
+func _Upcoming_ListUpcoming_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUpcomingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UpcomingServer).ListUpcoming(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/upcoming.Upcoming/ListUpcoming",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UpcomingServer).ListUpcoming(ctx, req.(*ListUpcomingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Upcoming_ServiceDesc is the grpc.ServiceDesc for Upcoming service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Upcoming_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "upcoming.Upcoming",
+	HandlerType: (*UpcomingServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListUpcoming",
+			Handler:    _Upcoming_ListUpcoming_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "upcoming/upcoming.proto",
+}
@@ -0,0 +1,158 @@
+// Package query parses the query parameters of the GET routes into their requests, e.g. GET /v1/races into a
+// ListRacesRequest.
+//
+// On top of the field paths of the gateway, e.g. filter.meeting_ids=5, it takes the fields of the filter without their
+// prefix, e.g. meeting_ids=5, and the order by as a comma separated list of fields with their direction, e.g.
+// order_by=advertised_start_time desc,name.
+package query
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// The fields of the list requests the shorthands are for.
+const (
+	filterField        = "filter"
+	orderByFieldsField = "order_by_fields"
+)
+
+// Parser parses the query parameters of the requests. Use it with runtime.SetQueryParameterParser.
+type Parser struct{}
+
+// NewParser instantiates and returns a new Parser.
+func NewParser() *Parser {
+	return &Parser{}
+}
+
+// Parse populates the request from the query parameters. The parameters that aren't fields of the request are ignored,
+// like with the default parser of the gateway, and so are the ones in the filter, i.e. the fields bound to the path.
+func (p *Parser) Parse(msg proto.Message, values url.Values, filter *utilities.DoubleArray) error {
+	m := msg.ProtoReflect()
+
+	for key, params := range values {
+		path := strings.Split(key, ".")
+		if filter.HasCommonPrefix(path) {
+			continue
+		}
+
+		if len(path) == 1 && field(m.Descriptor(), key) == nil {
+			if f := field(m.Descriptor(), filterField); f != nil && f.Message() != nil && field(f.Message(), key) != nil {
+				path = []string{filterField, key}
+			}
+		}
+
+		if f := field(m.Descriptor(), path[0]); len(path) == 1 && isOrderBy(f) {
+			if err := parseOrderBy(m, f, params); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if err := populate(msg, m.Descriptor(), path, params); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// populate sets a field like the default parser of the gateway, which only takes one value for the singular fields.
+func populate(msg proto.Message, desc protoreflect.MessageDescriptor, path []string, params []string) error {
+	f := resolve(desc, path)
+	if f != nil && !f.IsList() && len(params) > 1 {
+		return fmt.Errorf("too many values for field %q: %s", strings.Join(path, "."), strings.Join(params, ", "))
+	}
+
+	for _, param := range params {
+		if err := runtime.PopulateFieldFromPath(msg, strings.Join(path, "."), param); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseOrderBy appends the fields of order_by=advertised_start_time desc,name to the order by of the request. The
+// direction is ascending by default.
+func parseOrderBy(m protoreflect.Message, orderBy protoreflect.FieldDescriptor, params []string) error {
+	fields := m.Mutable(orderBy).Message().Mutable(field(orderBy.Message(), orderByFieldsField)).List()
+	desc := field(orderBy.Message(), orderByFieldsField).Message()
+
+	for _, param := range params {
+		for _, item := range strings.Split(param, ",") {
+			parts := strings.Fields(item)
+			if len(parts) == 0 || len(parts) > 2 {
+				return fmt.Errorf("invalid order by %q, expected a field optionally followed by asc or desc", item)
+			}
+
+			direction := "ASC"
+			if len(parts) == 2 {
+				direction = strings.ToUpper(parts[1])
+			}
+
+			value := desc.Fields().ByName("direction").Enum().Values().ByName(protoreflect.Name(direction))
+			if value == nil {
+				return fmt.Errorf("invalid order by direction %q, expected asc or desc", parts[1])
+			}
+
+			element := fields.NewElement().Message()
+			element.Set(desc.Fields().ByName("field"), protoreflect.ValueOfString(parts[0]))
+			element.Set(desc.Fields().ByName("direction"), protoreflect.ValueOfEnum(value.Number()))
+			fields.Append(protoreflect.ValueOfMessage(element))
+		}
+	}
+
+	return nil
+}
+
+// isOrderBy reports whether the field is an order by, i.e. a message with a list of fields and their direction.
+func isOrderBy(f protoreflect.FieldDescriptor) bool {
+	if f == nil || f.Message() == nil || f.IsList() {
+		return false
+	}
+
+	fields := field(f.Message(), orderByFieldsField)
+	if fields == nil || !fields.IsList() || fields.Message() == nil {
+		return false
+	}
+
+	name, direction := fields.Message().Fields().ByName("field"), fields.Message().Fields().ByName("direction")
+
+	return name != nil && name.Kind() == protoreflect.StringKind && direction != nil && direction.Enum() != nil
+}
+
+// resolve returns the field at the end of a path, or nil if there's none.
+func resolve(desc protoreflect.MessageDescriptor, path []string) protoreflect.FieldDescriptor {
+	var f protoreflect.FieldDescriptor
+
+	for _, name := range path {
+		if desc == nil {
+			return nil
+		}
+
+		if f = field(desc, name); f == nil {
+			return nil
+		}
+
+		desc = f.Message()
+	}
+
+	return f
+}
+
+// field returns the field of a message by its proto or its JSON name, e.g. meeting_ids or meetingIds.
+func field(desc protoreflect.MessageDescriptor, name string) protoreflect.FieldDescriptor {
+	if f := desc.Fields().ByName(protoreflect.Name(name)); f != nil {
+		return f
+	}
+
+	return desc.Fields().ByJSONName(name)
+}
@@ -0,0 +1,86 @@
+package query
+
+import (
+	"net/url"
+	"testing"
+
+	"git.neds.sh/matty/entain/api/proto/racing"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		want    *racing.ListRacesRequest
+		wantErr bool
+	}{
+		{name: "empty", query: "", want: &racing.ListRacesRequest{}},
+		{
+			name:  "field paths",
+			query: "filter.meeting_ids=1&filter.meeting_ids=2&filter.country=AU",
+			want:  &racing.ListRacesRequest{Filter: &racing.ListRacesRequestFilter{MeetingIds: []int64{1, 2}, Country: "AU"}},
+		},
+		{
+			name:  "fields of the filter without their prefix",
+			query: "meeting_ids=1&meetingIds=2&meeting_visibility=true",
+			want:  &racing.ListRacesRequest{Filter: &racing.ListRacesRequestFilter{MeetingIds: []int64{1, 2}, MeetingVisibility: proto.Bool(true)}},
+		},
+		{
+			name:  "order by",
+			query: "order_by=advertised_start_time desc,name",
+			want: &racing.ListRacesRequest{OrderBy: &racing.ListRacesRequestOrderBy{OrderByFields: []*racing.OrderByField{
+				{Field: "advertised_start_time", Direction: racing.OrderByField_DESC},
+				{Field: "name", Direction: racing.OrderByField_ASC},
+			}}},
+		},
+		{
+			name:  "order by direction in any case",
+			query: "order_by=name%20Asc",
+			want: &racing.ListRacesRequest{OrderBy: &racing.ListRacesRequestOrderBy{OrderByFields: []*racing.OrderByField{
+				{Field: "name", Direction: racing.OrderByField_ASC},
+			}}},
+		},
+		{name: "unknown parameters ignored", query: "page=2", want: &racing.ListRacesRequest{}},
+		{name: "order by direction", query: "order_by=name sideways", wantErr: true},
+		{name: "order by too many parts", query: "order_by=name desc asc", wantErr: true},
+		{name: "order by empty item", query: "order_by=name,,number", wantErr: true},
+		{name: "too many values for a singular field", query: "country=AU&country=NZ", wantErr: true},
+		{name: "invalid value", query: "meeting_ids=one", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := url.ParseQuery(tt.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := &racing.ListRacesRequest{}
+
+			err = NewParser().Parse(got, values, &utilities.DoubleArray{})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse(%q) error = %v, wantErr %v", tt.query, err, tt.wantErr)
+			}
+
+			if !tt.wantErr && !proto.Equal(got, tt.want) {
+				t.Errorf("Parse(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSkipsThePathFields(t *testing.T) {
+	values := url.Values{"id": {"5"}}
+	filter := utilities.NewDoubleArray([][]string{{"id"}})
+
+	got := &racing.GetRaceRequest{Id: 1}
+	if err := NewParser().Parse(got, values, filter); err != nil {
+		t.Fatal(err)
+	}
+
+	if got.Id != 1 {
+		t.Errorf("Parse() set the id bound to the path to %d, want 1", got.Id)
+	}
+}
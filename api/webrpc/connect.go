@@ -0,0 +1,271 @@
+package webrpc
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// connectCodes are the names and the HTTP statuses of the codes in the Connect protocol.
+var connectCodes = map[codes.Code]struct {
+	name   string
+	status int
+}{
+	codes.Canceled:           {"canceled", 499},
+	codes.Unknown:            {"unknown", http.StatusInternalServerError},
+	codes.InvalidArgument:    {"invalid_argument", http.StatusBadRequest},
+	codes.DeadlineExceeded:   {"deadline_exceeded", http.StatusGatewayTimeout},
+	codes.NotFound:           {"not_found", http.StatusNotFound},
+	codes.AlreadyExists:      {"already_exists", http.StatusConflict},
+	codes.PermissionDenied:   {"permission_denied", http.StatusForbidden},
+	codes.ResourceExhausted:  {"resource_exhausted", http.StatusTooManyRequests},
+	codes.FailedPrecondition: {"failed_precondition", http.StatusBadRequest},
+	codes.Aborted:            {"aborted", http.StatusConflict},
+	codes.OutOfRange:         {"out_of_range", http.StatusBadRequest},
+	codes.Unimplemented:      {"unimplemented", http.StatusNotImplemented},
+	codes.Internal:           {"internal", http.StatusInternalServerError},
+	codes.Unavailable:        {"unavailable", http.StatusServiceUnavailable},
+	codes.DataLoss:           {"data_loss", http.StatusInternalServerError},
+	codes.Unauthenticated:    {"unauthenticated", http.StatusUnauthorized},
+}
+
+// connectError is an error in the Connect protocol. The details are the protobuf encoded details of the gRPC status,
+// e.g. the google.rpc.RequestInfo with the request id.
+type connectError struct {
+	Code    string          `json:"code"`
+	Message string          `json:"message,omitempty"`
+	Details []connectDetail `json:"details,omitempty"`
+}
+
+type connectDetail struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+func newConnectError(err error) *connectError {
+	st := status.Convert(err)
+	e := &connectError{Code: connectCodes[st.Code()].name, Message: st.Message()}
+
+	for _, detail := range st.Proto().Details {
+		e.Details = append(e.Details, connectDetail{
+			Type:  detail.TypeUrl[strings.LastIndex(detail.TypeUrl, "/")+1:],
+			Value: base64.RawStdEncoding.EncodeToString(detail.Value),
+		})
+	}
+
+	return e
+}
+
+// connectUnaryWriter keeps the response of a unary call, which is only written once the trailers are known.
+type connectUnaryWriter struct {
+	codec  codec
+	header metadata.MD
+	body   []byte
+}
+
+func (c *connectUnaryWriter) writeHeader(header metadata.MD) {
+	c.header = header
+}
+
+func (c *connectUnaryWriter) writeMessage(msg proto.Message) error {
+	b, err := c.codec.marshal(msg)
+	c.body = b
+
+	return err
+}
+
+// serveConnectUnary serves a unary call of the Connect protocol. The message is the body of the request and of the
+// response, the trailers are sent as Trailer- prefixed headers and the errors as JSON with the HTTP status of their code.
+func (h *Handler) serveConnectUnary(w http.ResponseWriter, r *http.Request, m *method, contentType string) {
+	c := protoCodec
+	if contentType == "application/json" {
+		c = jsonCodec
+	}
+
+	in, err := h.readConnectUnaryRequest(r, m, c)
+	if err != nil {
+		writeConnectError(w, withRequestID(r, err))
+		return
+	}
+
+	ctx, cancel, err := timeout(r, r.Header.Get("Connect-Timeout-Ms"), parseMillis)
+	if err != nil {
+		writeConnectError(w, withRequestID(r, err))
+		return
+	}
+	defer cancel()
+
+	out := &connectUnaryWriter{codec: c}
+	trailer, err := h.call(ctx, r, m, in, out)
+
+	setMetadata(w.Header(), out.header, "")
+	setMetadata(w.Header(), trailer, "Trailer-")
+
+	if err != nil {
+		writeConnectError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Write(out.body)
+}
+
+func (h *Handler) readConnectUnaryRequest(r *http.Request, m *method, c codec) (proto.Message, error) {
+	if err := check(m); err != nil {
+		return nil, err
+	}
+
+	if m.desc.IsStreamingServer() {
+		return nil, status.Errorf(codes.Unimplemented, "%s is a server streaming method, call it with application/connect+proto or application/connect+json", m.path)
+	}
+
+	if encoding := r.Header.Get("Content-Encoding"); encoding != "" && encoding != "identity" {
+		return nil, status.Errorf(codes.Unimplemented, "unsupported content encoding %s", encoding)
+	}
+
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %s", err)
+	}
+
+	in := m.input.New().Interface()
+	if err := c.unmarshal(b, in); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %s", err)
+	}
+
+	return in, nil
+}
+
+func writeConnectError(w http.ResponseWriter, err error) {
+	b, _ := json.Marshal(newConnectError(err))
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(connectCodes[status.Code(err)].status)
+	w.Write(b)
+}
+
+// connectStreamWriter writes the responses of a streaming call in envelopes, followed by an end of stream message with
+// the error and the trailers.
+type connectStreamWriter struct {
+	w           http.ResponseWriter
+	codec       codec
+	wroteHeader bool
+}
+
+// connectEndStream is the end of stream message of the Connect protocol.
+type connectEndStream struct {
+	Error    *connectError       `json:"error,omitempty"`
+	Metadata map[string][]string `json:"metadata,omitempty"`
+}
+
+func (c *connectStreamWriter) writeHeader(header metadata.MD) {
+	if c.wroteHeader {
+		return
+	}
+
+	setMetadata(c.w.Header(), header, "")
+	c.w.WriteHeader(http.StatusOK)
+	c.wroteHeader = true
+}
+
+func (c *connectStreamWriter) writeMessage(msg proto.Message) error {
+	b, err := c.codec.marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	return c.write(envelope(0, b))
+}
+
+func (c *connectStreamWriter) writeEnd(trailer metadata.MD, err error) {
+	c.writeHeader(nil)
+
+	end := connectEndStream{}
+	if err != nil {
+		end.Error = newConnectError(err)
+	}
+
+	if len(trailer) > 0 {
+		header := http.Header{}
+		setMetadata(header, trailer, "")
+		end.Metadata = header
+	}
+
+	b, _ := json.Marshal(end)
+	c.write(envelope(flagEndStream, b))
+}
+
+func (c *connectStreamWriter) write(b []byte) error {
+	if _, err := c.w.Write(b); err != nil {
+		return err
+	}
+
+	if f, ok := c.w.(http.Flusher); ok {
+		f.Flush()
+	}
+
+	return nil
+}
+
+// serveConnectStream serves a server streaming call of the Connect protocol. The response is always a 200, the status
+// of the call comes in the end of stream message.
+func (h *Handler) serveConnectStream(w http.ResponseWriter, r *http.Request, m *method, contentType string) {
+	c, ok := codecFor(contentType)
+	if !ok {
+		http.Error(w, "unsupported content type "+contentType, http.StatusUnsupportedMediaType)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+
+	out := &connectStreamWriter{w: w, codec: c}
+
+	in, err := h.readConnectStreamRequest(r, m, c)
+	if err != nil {
+		out.writeEnd(nil, withRequestID(r, err))
+		return
+	}
+
+	ctx, cancel, err := timeout(r, r.Header.Get("Connect-Timeout-Ms"), parseMillis)
+	if err != nil {
+		out.writeEnd(nil, withRequestID(r, err))
+		return
+	}
+	defer cancel()
+
+	trailer, err := h.call(ctx, r, m, in, out)
+	out.writeEnd(trailer, err)
+}
+
+func (h *Handler) readConnectStreamRequest(r *http.Request, m *method, c codec) (proto.Message, error) {
+	if err := check(m); err != nil {
+		return nil, err
+	}
+
+	if !m.desc.IsStreamingServer() {
+		return nil, status.Errorf(codes.Unimplemented, "%s is a unary method, call it with application/proto or application/json", m.path)
+	}
+
+	if encoding := r.Header.Get("Connect-Content-Encoding"); encoding != "" && encoding != "identity" {
+		return nil, status.Errorf(codes.Unimplemented, "unsupported content encoding %s", encoding)
+	}
+
+	b, err := readEnvelope(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	in := m.input.New().Interface()
+	if err := c.unmarshal(b, in); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %s", err)
+	}
+
+	return in, nil
+}
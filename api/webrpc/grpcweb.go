@@ -0,0 +1,190 @@
+package webrpc
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// grpcWebWriter writes the responses of a gRPC-Web call. The text variant of the protocol base64 encodes every envelope
+// on its own, which the clients decode as they come.
+type grpcWebWriter struct {
+	w           http.ResponseWriter
+	codec       codec
+	text        bool
+	wroteHeader bool
+}
+
+func (g *grpcWebWriter) writeHeader(header metadata.MD) {
+	if g.wroteHeader {
+		return
+	}
+
+	setMetadata(g.w.Header(), header, "")
+	g.w.WriteHeader(http.StatusOK)
+	g.wroteHeader = true
+}
+
+func (g *grpcWebWriter) writeMessage(msg proto.Message) error {
+	b, err := g.codec.marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	return g.write(envelope(0, b))
+}
+
+// writeTrailer ends the response with the status of the call and the trailer metadata of the backend.
+func (g *grpcWebWriter) writeTrailer(trailer metadata.MD, err error) {
+	g.writeHeader(nil)
+
+	st := status.Convert(err)
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "grpc-status: %d\r\n", st.Code())
+
+	if st.Message() != "" {
+		fmt.Fprintf(&b, "grpc-message: %s\r\n", percentEncode(st.Message()))
+	}
+
+	if len(st.Details()) > 0 {
+		if details, err := proto.Marshal(st.Proto()); err == nil {
+			fmt.Fprintf(&b, "grpc-status-details-bin: %s\r\n", base64.RawStdEncoding.EncodeToString(details))
+		}
+	}
+
+	header := http.Header{}
+	setMetadata(header, trailer, "")
+
+	for key, values := range header {
+		for _, value := range values {
+			fmt.Fprintf(&b, "%s: %s\r\n", strings.ToLower(key), value)
+		}
+	}
+
+	g.write(envelope(flagTrailer, b.Bytes()))
+}
+
+func (g *grpcWebWriter) write(b []byte) error {
+	if g.text {
+		b = []byte(base64.StdEncoding.EncodeToString(b))
+	}
+
+	if _, err := g.w.Write(b); err != nil {
+		return err
+	}
+
+	if f, ok := g.w.(http.Flusher); ok {
+		f.Flush()
+	}
+
+	return nil
+}
+
+// serveGRPCWeb serves a call of the gRPC-Web protocol. The status of the call is always sent in the trailers, so the
+// response is a 200 unless the request isn't gRPC-Web at all.
+func (h *Handler) serveGRPCWeb(w http.ResponseWriter, r *http.Request, m *method, contentType string) {
+	text := strings.HasPrefix(contentType, "application/grpc-web-text")
+
+	c, ok := codecFor(contentType)
+	if !ok {
+		http.Error(w, "unsupported content type "+contentType, http.StatusUnsupportedMediaType)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+
+	out := &grpcWebWriter{w: w, codec: c, text: text}
+
+	in, err := h.readGRPCWebRequest(r, m, c, text)
+	if err != nil {
+		out.writeTrailer(nil, withRequestID(r, err))
+		return
+	}
+
+	ctx, cancel, err := timeout(r, r.Header.Get("Grpc-Timeout"), parseGRPCTimeout)
+	if err != nil {
+		out.writeTrailer(nil, withRequestID(r, err))
+		return
+	}
+	defer cancel()
+
+	trailer, err := h.call(ctx, r, m, in, out)
+	out.writeTrailer(trailer, err)
+}
+
+func (h *Handler) readGRPCWebRequest(r *http.Request, m *method, c codec, text bool) (proto.Message, error) {
+	if err := check(m); err != nil {
+		return nil, err
+	}
+
+	var body io.Reader = r.Body
+	if text {
+		body = base64.NewDecoder(base64.StdEncoding, body)
+	}
+
+	b, err := readEnvelope(body)
+	if err != nil {
+		return nil, err
+	}
+
+	in := m.input.New().Interface()
+	if err := c.unmarshal(b, in); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %s", err)
+	}
+
+	return in, nil
+}
+
+// parseGRPCTimeout parses the value of the grpc-timeout header, e.g. 500m or 2S.
+func parseGRPCTimeout(value string) (time.Duration, bool) {
+	if len(value) < 2 || len(value) > 9 {
+		return 0, false
+	}
+
+	units := map[byte]time.Duration{
+		'H': time.Hour,
+		'M': time.Minute,
+		'S': time.Second,
+		'm': time.Millisecond,
+		'u': time.Microsecond,
+		'n': time.Nanosecond,
+	}
+
+	unit, ok := units[value[len(value)-1]]
+	if !ok {
+		return 0, false
+	}
+
+	n, err := strconv.ParseInt(value[:len(value)-1], 10, 64)
+	if err != nil || n < 0 {
+		return 0, false
+	}
+
+	return time.Duration(n) * unit, true
+}
+
+// percentEncode encodes the grpc-message like gRPC does, leaving only printable ASCII.
+func percentEncode(message string) string {
+	var b strings.Builder
+
+	for i := 0; i < len(message); i++ {
+		if c := message[i]; c >= ' ' && c <= '~' && c != '%' {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+
+	return b.String()
+}
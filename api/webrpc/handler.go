@@ -0,0 +1,359 @@
+// Package webrpc serves the gRPC services of the backends to browsers, over the gRPC-Web and Connect protocols.
+//
+// Both protocols post to /<service>/<method>, e.g. /racing.Racing/ListRaces, and only support the unary and the
+// server streaming methods over HTTP/1.1. The calls are proxied to the backends like the REST routes are.
+package webrpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"git.neds.sh/matty/entain/api/logging"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// maxRequestBody is the biggest request accepted.
+const maxRequestBody = 1 << 20
+
+// Backend is a gRPC service served by the handler, with the connection to its server.
+type Backend struct {
+	// Service is the full name of the service, e.g. racing.Racing.
+	Service string
+	Conn    *grpc.ClientConn
+}
+
+// method is a method of a backend.
+type method struct {
+	path   string
+	conn   *grpc.ClientConn
+	desc   protoreflect.MethodDescriptor
+	input  protoreflect.MessageType
+	output protoreflect.MessageType
+}
+
+// Handler serves the gRPC-Web and Connect routes.
+type Handler struct {
+	backends   []Backend
+	methods    map[string]*method
+	annotators []func(context.Context, *http.Request) metadata.MD
+}
+
+// NewHandler instantiates and returns a new Handler. The services must be registered with the protobuf registry, i.e.
+// their generated packages imported. Only the metadata added by the annotators is forwarded to the backends, the same as
+// the gateway's runtime.WithMetadata ones, not the headers of the clients.
+func NewHandler(backends []Backend, annotators ...func(context.Context, *http.Request) metadata.MD) (*Handler, error) {
+	h := &Handler{backends: backends, methods: map[string]*method{}, annotators: annotators}
+
+	for _, backend := range backends {
+		d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(backend.Service))
+		if err != nil {
+			return nil, fmt.Errorf("service %s: %w", backend.Service, err)
+		}
+
+		service, ok := d.(protoreflect.ServiceDescriptor)
+		if !ok {
+			return nil, fmt.Errorf("%s is not a service", backend.Service)
+		}
+
+		for i := 0; i < service.Methods().Len(); i++ {
+			desc := service.Methods().Get(i)
+
+			input, err := protoregistry.GlobalTypes.FindMessageByName(desc.Input().FullName())
+			if err != nil {
+				return nil, err
+			}
+
+			output, err := protoregistry.GlobalTypes.FindMessageByName(desc.Output().FullName())
+			if err != nil {
+				return nil, err
+			}
+
+			path := "/" + backend.Service + "/" + string(desc.Name())
+			h.methods[path] = &method{path: path, conn: backend.Conn, desc: desc, input: input, output: output}
+		}
+	}
+
+	return h, nil
+}
+
+// Register adds the routes of the services to the mux, one per service.
+func (h *Handler) Register(mux *http.ServeMux) {
+	for _, backend := range h.backends {
+		mux.Handle("/"+backend.Service+"/", h)
+	}
+}
+
+// Routes returns the routes of the methods, e.g. /racing.Racing/ListRaces.
+func (h *Handler) Routes() []string {
+	var routes []string
+	for path := range h.methods {
+		routes = append(routes, path)
+	}
+
+	return routes
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "the gRPC-Web and Connect routes only accept POST requests", http.StatusMethodNotAllowed)
+		return
+	}
+
+	contentType := r.Header.Get("Content-Type")
+	if i := strings.Index(contentType, ";"); i >= 0 {
+		contentType = contentType[:i]
+	}
+	contentType = strings.TrimSpace(strings.ToLower(contentType))
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxRequestBody)
+	m := h.methods[r.URL.Path]
+
+	switch {
+	case strings.HasPrefix(contentType, "application/grpc-web"):
+		h.serveGRPCWeb(w, r, m, contentType)
+	case strings.HasPrefix(contentType, "application/connect+"):
+		h.serveConnectStream(w, r, m, contentType)
+	case contentType == "application/proto" || contentType == "application/json":
+		h.serveConnectUnary(w, r, m, contentType)
+	default:
+		http.Error(w, "unsupported content type "+contentType+", use gRPC-Web or Connect", http.StatusUnsupportedMediaType)
+	}
+}
+
+// responseWriter writes the responses of a call in the protocol of the client.
+type responseWriter interface {
+	// writeHeader is called with the header metadata of the backend before the first message.
+	writeHeader(header metadata.MD)
+	writeMessage(msg proto.Message) error
+}
+
+// call calls the method on its backend and writes its responses as they come. It returns the trailer metadata of the
+// backend and its error, with the request id in the details.
+func (h *Handler) call(ctx context.Context, r *http.Request, m *method, in proto.Message, out responseWriter) (metadata.MD, error) {
+	var md metadata.MD
+	for _, annotator := range h.annotators {
+		md = metadata.Join(md, annotator(ctx, r))
+	}
+
+	ctx, cancel := context.WithCancel(metadata.NewOutgoingContext(ctx, md))
+	defer cancel()
+
+	trailer, err := h.stream(ctx, m, in, out)
+
+	return trailer, withRequestID(r, err)
+}
+
+func (h *Handler) stream(ctx context.Context, m *method, in proto.Message, out responseWriter) (metadata.MD, error) {
+	stream, err := m.conn.NewStream(ctx, &grpc.StreamDesc{ServerStreams: m.desc.IsStreamingServer()}, m.path)
+	if err != nil {
+		return nil, err
+	}
+
+	// io.EOF means the backend already ended the call, its status comes with RecvMsg.
+	if err := stream.SendMsg(in); err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	if err := stream.CloseSend(); err != nil {
+		return nil, err
+	}
+
+	header, err := stream.Header()
+	if err != nil {
+		return stream.Trailer(), err
+	}
+
+	out.writeHeader(header)
+
+	for {
+		msg := m.output.New().Interface()
+
+		if err := stream.RecvMsg(msg); err != nil {
+			if err == io.EOF {
+				err = nil
+			}
+
+			return stream.Trailer(), err
+		}
+
+		if err := out.writeMessage(msg); err != nil {
+			return stream.Trailer(), status.Errorf(codes.Canceled, "failed writing the response: %s", err)
+		}
+
+		if !m.desc.IsStreamingServer() {
+			return stream.Trailer(), nil
+		}
+	}
+}
+
+// check returns the error of a method the protocols can't call, or that doesn't exist.
+func check(m *method) error {
+	if m == nil {
+		return status.Error(codes.Unimplemented, "unknown method")
+	}
+
+	if m.desc.IsStreamingClient() {
+		return status.Errorf(codes.Unimplemented, "%s is a client streaming method, gRPC-Web and Connect only support the unary and the server streaming ones", m.path)
+	}
+
+	return nil
+}
+
+// withRequestID adds the request id to the details of the error, as a google.rpc.RequestInfo, like the errors of the
+// REST routes.
+func withRequestID(r *http.Request, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	st := status.Convert(err)
+
+	if id := logging.RequestID(r.Context()); id != "" {
+		if withDetails, detailsErr := st.WithDetails(&errdetails.RequestInfo{RequestId: id}); detailsErr == nil {
+			st = withDetails
+		}
+	}
+
+	return st.Err()
+}
+
+// codec encodes the messages in the format of the content type.
+type codec struct {
+	marshal   func(proto.Message) ([]byte, error)
+	unmarshal func([]byte, proto.Message) error
+}
+
+var (
+	protoCodec = codec{marshal: proto.Marshal, unmarshal: proto.Unmarshal}
+	// jsonCodec encodes the messages the same as the REST routes encode their responses.
+	jsonCodec = codec{
+		marshal:   protojson.MarshalOptions{EmitUnpopulated: true}.Marshal,
+		unmarshal: protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal,
+	}
+)
+
+// codecFor returns the codec of the suffix of a content type, e.g. json for application/connect+json.
+func codecFor(contentType string) (codec, bool) {
+	suffix := ""
+	if i := strings.LastIndex(contentType, "+"); i >= 0 {
+		suffix = contentType[i+1:]
+	}
+
+	switch suffix {
+	case "", "proto":
+		return protoCodec, true
+	case "json":
+		return jsonCodec, true
+	}
+
+	return codec{}, false
+}
+
+// The flags of the envelopes framing the messages, which both protocols share with gRPC.
+const (
+	flagCompressed = 0x01
+	// flagEndStream marks the end of a Connect stream.
+	flagEndStream = 0x02
+	// flagTrailer marks the trailers of a gRPC-Web response.
+	flagTrailer = 0x80
+)
+
+// readEnvelope reads the single message of a request.
+func readEnvelope(r io.Reader) ([]byte, error) {
+	prefix := make([]byte, 5)
+	if _, err := io.ReadFull(r, prefix); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %s", err)
+	}
+
+	if prefix[0]&flagCompressed != 0 {
+		return nil, status.Error(codes.Unimplemented, "compressed messages aren't supported")
+	}
+
+	size := int64(prefix[1])<<24 | int64(prefix[2])<<16 | int64(prefix[3])<<8 | int64(prefix[4])
+
+	// The size comes from the client, so it's checked before reading, and the message is read as it comes rather than
+	// into a buffer of that size.
+	if size > maxRequestBody {
+		return nil, status.Errorf(codes.ResourceExhausted, "message of %d bytes is bigger than the maximum of %d", size, maxRequestBody)
+	}
+
+	var b bytes.Buffer
+	n, err := b.ReadFrom(io.LimitReader(r, size))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %s", err)
+	}
+
+	if n < size {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %s", io.ErrUnexpectedEOF)
+	}
+
+	return b.Bytes(), nil
+}
+
+// envelope frames a message or the trailers.
+func envelope(flags byte, b []byte) []byte {
+	size := len(b)
+
+	return append([]byte{flags, byte(size >> 24), byte(size >> 16), byte(size >> 8), byte(size)}, b...)
+}
+
+// timeout returns the context of a call with the timeout requested by the client, if any.
+func timeout(r *http.Request, value string, parse func(string) (time.Duration, bool)) (context.Context, context.CancelFunc, error) {
+	if value == "" {
+		ctx, cancel := context.WithCancel(r.Context())
+		return ctx, cancel, nil
+	}
+
+	d, ok := parse(value)
+	if !ok {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "invalid timeout %q", value)
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), d)
+
+	return ctx, cancel, nil
+}
+
+// setMetadata sets the metadata of the backend as headers, with the binary values base64 encoded. The reserved gRPC
+// headers are left out.
+func setMetadata(header http.Header, md metadata.MD, prefix string) {
+	for key, values := range md {
+		if strings.HasPrefix(key, "grpc-") || strings.HasPrefix(key, ":") || key == "content-type" {
+			continue
+		}
+
+		for _, value := range values {
+			if strings.HasSuffix(key, "-bin") {
+				value = base64.RawStdEncoding.EncodeToString([]byte(value))
+			}
+
+			header.Add(prefix+key, value)
+		}
+	}
+}
+
+// parseMillis parses the value of the Connect-Timeout-Ms header.
+func parseMillis(value string) (time.Duration, bool) {
+	ms, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || ms < 0 || len(value) > 10 {
+		return 0, false
+	}
+
+	return time.Duration(ms) * time.Millisecond, true
+}
@@ -0,0 +1,181 @@
+package ws
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"git.neds.sh/matty/entain/api/proto/racing"
+	"git.neds.sh/matty/entain/api/proto/sports"
+	"github.com/gorilla/websocket"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxMessageSize is the biggest message accepted from a client.
+	maxMessageSize = 4 << 10
+	// writeTimeout is how long a message or a ping can take to be written to a connection.
+	writeTimeout = 10 * time.Second
+)
+
+// Config configures the WebSocket connections.
+type Config struct {
+	// SendQueue is the number of messages that can be queued for a connection. A connection is closed when its queue
+	// is full, rather than holding up the updates of the others.
+	SendQueue int
+	// MaxTopics is the number of topics a connection can subscribe to.
+	MaxTopics int
+	// PingInterval is how often the connections are pinged.
+	PingInterval time.Duration
+	// PongTimeout is how long a connection has to answer a ping before it's closed.
+	PongTimeout time.Duration
+}
+
+// Handler serves the WebSocket endpoint.
+type Handler struct {
+	hub      *hub
+	config   Config
+	upgrader websocket.Upgrader
+}
+
+// NewHandler instantiates and returns a new Handler. The backend streams are shared by all the connections.
+func NewHandler(racingClient racing.RacingClient, sportsClient sports.SportsClient, config Config) *Handler {
+	return &Handler{hub: newHub(racingClient, sportsClient), config: config}
+}
+
+// conn is a WebSocket connection. Its messages are queued and written by a single goroutine.
+type conn struct {
+	ws       *websocket.Conn
+	queue    chan []byte
+	slow     chan struct{}
+	slowOnce sync.Once
+	// topics is guarded by the lock of the hub.
+	topics map[topic]bool
+}
+
+// send queues a message without blocking. The connection is closed when its queue is full.
+func (c *conn) send(msg []byte) {
+	select {
+	case c.queue <- msg:
+	default:
+		c.slowOnce.Do(func() { close(c.slow) })
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// The upgrader replies with an error itself, e.g. to the requests that aren't WebSocket handshakes.
+	// Its response keeps the headers set by the middlewares, e.g. the request id.
+	ws, err := h.upgrader.Upgrade(w, r, w.Header())
+	if err != nil {
+		return
+	}
+
+	c := &conn{
+		ws:     ws,
+		queue:  make(chan []byte, h.config.SendQueue),
+		slow:   make(chan struct{}),
+		topics: map[topic]bool{},
+	}
+
+	done := make(chan struct{})
+	go h.write(c, done)
+
+	h.read(c)
+
+	h.hub.close(c)
+	close(done)
+}
+
+// read handles the messages of the client until the connection is closed or stops answering the pings.
+func (h *Handler) read(c *conn) {
+	extendDeadline := func() {
+		c.ws.SetReadDeadline(time.Now().Add(h.config.PingInterval + h.config.PongTimeout))
+	}
+
+	c.ws.SetReadLimit(maxMessageSize)
+	c.ws.SetPongHandler(func(string) error {
+		extendDeadline()
+		return nil
+	})
+	extendDeadline()
+
+	for {
+		_, b, err := c.ws.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				log.Debugf("websocket connection closed: %s", err)
+			}
+
+			return
+		}
+
+		extendDeadline()
+		h.handle(c, b)
+	}
+}
+
+func (h *Handler) handle(c *conn, b []byte) {
+	var req request
+	if err := json.Unmarshal(b, &req); err != nil {
+		c.send(reply{Type: typeError, Error: "invalid message: " + err.Error()}.encode())
+		return
+	}
+
+	switch req.Type {
+	case typePing:
+		c.send(reply{Type: typePong, ID: req.ID}.encode())
+		return
+	case typeSubscribe, typeUnsubscribe:
+	default:
+		c.send(reply{Type: typeError, ID: req.ID, Error: "unknown message type " + req.Type + ", the types are subscribe, unsubscribe and ping"}.encode())
+		return
+	}
+
+	topics, err := parseTopics(req.Topics)
+	if err != nil {
+		c.send(reply{Type: typeError, ID: req.ID, Error: err.Error()}.encode())
+		return
+	}
+
+	if req.Type == typeUnsubscribe {
+		h.hub.unsubscribe(c, req.ID, topics)
+		return
+	}
+
+	if err := h.hub.subscribe(c, req.ID, topics, h.config.MaxTopics); err != nil {
+		c.send(reply{Type: typeError, ID: req.ID, Error: status.Convert(err).Message()}.encode())
+	}
+}
+
+// write writes the queued messages and the pings to the connection, and closes it once the client is gone or too slow.
+func (h *Handler) write(c *conn, done <-chan struct{}) {
+	ticker := time.NewTicker(h.config.PingInterval)
+	defer ticker.Stop()
+	defer c.ws.Close()
+
+	for {
+		select {
+		case msg := <-c.queue:
+			c.ws.SetWriteDeadline(time.Now().Add(writeTimeout))
+
+			if err := c.ws.WriteMessage(websocket.TextMessage, msg); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if err := c.ws.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeTimeout)); err != nil {
+				return
+			}
+		case <-c.slow:
+			log.Warn("closing a websocket connection that fell too far behind its updates")
+
+			closing := websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "too far behind, reconnect and subscribe again")
+			c.ws.WriteControl(websocket.CloseMessage, closing, time.Now().Add(writeTimeout))
+
+			return
+		case <-done:
+			return
+		}
+	}
+}
@@ -0,0 +1,290 @@
+package ws
+
+import (
+	"context"
+	"sync"
+
+	"git.neds.sh/matty/entain/api/backend"
+	"git.neds.sh/matty/entain/api/proto/racing"
+	"git.neds.sh/matty/entain/api/proto/sports"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// marshaler encodes the updates the same as the REST routes encode their responses.
+var marshaler = protojson.MarshalOptions{EmitUnpopulated: true}
+
+// stream is a backend stream shared by the subscribers of one or more topics.
+type stream struct {
+	cancel context.CancelFunc
+}
+
+// hub tracks the topics of the connections and bridges them onto as few backend streams as possible: a single WatchRaces
+// stream for all the races and prices topics, and a WatchEvent stream per sports event with subscribers. A stream is
+// opened with the first subscriber of its topics and closed with the last one.
+type hub struct {
+	racing racing.RacingClient
+	sports sports.SportsClient
+
+	mu          sync.Mutex
+	subscribers map[topic]map[*conn]struct{}
+	races       *stream
+	events      map[int64]*stream
+}
+
+func newHub(racingClient racing.RacingClient, sportsClient sports.SportsClient) *hub {
+	return &hub{
+		racing:      racingClient,
+		sports:      sportsClient,
+		subscribers: map[topic]map[*conn]struct{}{},
+		events:      map[int64]*stream{},
+	}
+}
+
+// subscribe subscribes the connection to all the topics, or to none of them when it would go over maxTopics.
+// The reply is queued along with the subscription so that it comes before the first update.
+func (h *hub) subscribe(c *conn, id string, topics []topic, maxTopics int) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	count := len(c.topics)
+	for _, t := range topics {
+		if !c.topics[t] {
+			count++
+		}
+	}
+
+	if count > maxTopics {
+		return status.Errorf(codes.ResourceExhausted, "a connection can subscribe to at most %d topics", maxTopics)
+	}
+
+	for _, t := range topics {
+		if c.topics[t] {
+			continue
+		}
+
+		c.topics[t] = true
+
+		if h.subscribers[t] == nil {
+			h.subscribers[t] = map[*conn]struct{}{}
+		}
+		h.subscribers[t][c] = struct{}{}
+
+		h.start(t)
+	}
+
+	c.send(reply{Type: typeSubscribed, ID: id, Topics: names(topics)}.encode())
+
+	return nil
+}
+
+// unsubscribe unsubscribes the connection from the topics. No update of the topics is queued after the reply.
+func (h *hub) unsubscribe(c *conn, id string, topics []topic) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, t := range topics {
+		h.remove(c, t)
+	}
+
+	c.send(reply{Type: typeUnsubscribed, ID: id, Topics: names(topics)}.encode())
+}
+
+// close unsubscribes a connection that went away from all its topics.
+func (h *hub) close(c *conn) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for t := range c.topics {
+		h.remove(c, t)
+	}
+}
+
+// remove must be called with the lock held.
+func (h *hub) remove(c *conn, t topic) {
+	if !c.topics[t] {
+		return
+	}
+
+	delete(c.topics, t)
+	delete(h.subscribers[t], c)
+
+	if len(h.subscribers[t]) == 0 {
+		delete(h.subscribers, t)
+	}
+
+	h.stop(t)
+}
+
+// start opens the backend stream of the topic unless it's already open. It must be called with the lock held.
+func (h *hub) start(t topic) {
+	switch t.kind {
+	case kindRaces, kindPrices:
+		if h.races == nil {
+			ctx, cancel := context.WithCancel(context.Background())
+			h.races = &stream{cancel: cancel}
+
+			go h.watchRaces(ctx)
+		}
+	case kindScores:
+		if h.events[t.id] == nil {
+			ctx, cancel := context.WithCancel(context.Background())
+			s := &stream{cancel: cancel}
+			h.events[t.id] = s
+
+			go h.watchEvent(ctx, s, t.id)
+		}
+	}
+}
+
+// stop closes the backend stream of the topic once none of its topics has subscribers left.
+// It must be called with the lock held.
+func (h *hub) stop(t topic) {
+	switch t.kind {
+	case kindRaces, kindPrices:
+		if h.races == nil {
+			return
+		}
+
+		for other := range h.subscribers {
+			if other.kind == kindRaces || other.kind == kindPrices {
+				return
+			}
+		}
+
+		h.races.cancel()
+		h.races = nil
+	case kindScores:
+		if s := h.events[t.id]; s != nil && len(h.subscribers[t]) == 0 {
+			s.cancel()
+			delete(h.events, t.id)
+		}
+	}
+}
+
+// publish queues an update for the subscribers of its topic and of the topic matching all the races, once each.
+func (h *hub) publish(t topic, data proto.Message) {
+	b, err := marshaler.Marshal(data)
+	if err != nil {
+		log.Errorf("failed encoding the update of %s: %s", t, err)
+		return
+	}
+
+	msg := reply{Type: typeUpdate, Topic: t.String(), Data: b}.encode()
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for c := range h.subscribers[t] {
+		c.send(msg)
+	}
+
+	if t.kind == kindScores {
+		return
+	}
+
+	for c := range h.subscribers[topic{kind: t.kind, all: true}] {
+		if !c.topics[t] {
+			c.send(msg)
+		}
+	}
+}
+
+// fail tells the subscribers of a sports event that it can't be streamed, e.g. because it doesn't exist, and
+// unsubscribes them.
+func (h *hub) fail(s *stream, t topic, err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	// The stream was closed in the meantime, its subscribers are gone.
+	if h.events[t.id] != s {
+		return
+	}
+
+	msg := reply{Type: typeError, Topic: t.String(), Error: status.Convert(err).Message()}.encode()
+
+	for c := range h.subscribers[t] {
+		c.send(msg)
+		h.remove(c, t)
+	}
+}
+
+// watchRaces streams the updates of all the races until the context is done.
+func (h *hub) watchRaces(ctx context.Context) {
+	backend.Stream(ctx, "races", func(connected func()) error {
+		stream, err := h.racing.WatchRaces(ctx, &racing.WatchRacesRequest{})
+		if err != nil {
+			return err
+		}
+
+		for {
+			update, err := stream.Recv()
+			if err != nil {
+				return err
+			}
+
+			connected()
+
+			switch u := update.Update.(type) {
+			case *racing.RaceUpdate_Race:
+				h.publish(topic{kind: kindRaces, id: update.RaceId}, u.Race)
+			case *racing.RaceUpdate_Outright:
+				h.publish(topic{kind: kindPrices, id: update.RaceId}, u.Outright)
+			}
+		}
+	})
+}
+
+// watchEvent streams the updates of a sports event until the context is done. A reconnected stream resumes from the
+// last incident received so that none is missed.
+func (h *hub) watchEvent(ctx context.Context, s *stream, eventId int64) {
+	t := topic{kind: kindScores, id: eventId}
+	since := int64(-1)
+
+	err := backend.Stream(ctx, t.String(), func(connected func()) error {
+		// The subscribers only get the incidents from now on, the earlier ones can be listed with the REST routes.
+		if since < 0 {
+			response, err := h.sports.ListIncidents(ctx, &sports.ListIncidentsRequest{EventId: eventId})
+			if err != nil {
+				return err
+			}
+
+			since = response.LastSequence
+		}
+
+		stream, err := h.sports.WatchEvent(ctx, &sports.WatchEventRequest{EventId: eventId, SinceSequence: since})
+		if err != nil {
+			return err
+		}
+
+		for {
+			update, err := stream.Recv()
+			if err != nil {
+				return err
+			}
+
+			connected()
+
+			if incident := update.GetIncident(); incident != nil {
+				since = incident.Sequence
+			}
+
+			h.publish(t, update)
+		}
+	})
+	if err != nil {
+		h.fail(s, t, err)
+	}
+}
+
+func names(topics []topic) []string {
+	names := make([]string, len(topics))
+	for i, t := range topics {
+		names[i] = t.String()
+	}
+
+	return names
+}
@@ -0,0 +1,130 @@
+// Package ws serves the WebSocket endpoint of the gateway, which multiplexes the live updates of races and sports events
+// onto a single connection per client.
+package ws
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// The types of the messages sent by the clients.
+const (
+	typeSubscribe   = "subscribe"
+	typeUnsubscribe = "unsubscribe"
+	typePing        = "ping"
+)
+
+// The types of the messages sent to the clients.
+const (
+	typeSubscribed   = "subscribed"
+	typeUnsubscribed = "unsubscribed"
+	typePong         = "pong"
+	typeUpdate       = "update"
+	typeError        = "error"
+)
+
+// The kinds of topics. races.<race id> streams the status changes of a race, prices.<race id> the changes of its outright
+// markets and scores.<event id> the incidents, results and suspensions of a sports event. races.* and prices.* stream the
+// updates of all the races, there's no such topic for sports events.
+const (
+	kindRaces  = "races"
+	kindPrices = "prices"
+	kindScores = "scores"
+)
+
+// wildcard is the id of the topics matching all the races.
+const wildcard = "*"
+
+// request is a message sent by a client. The id is optional and echoed in the reply so that the client can match them.
+type request struct {
+	Type   string   `json:"type"`
+	ID     string   `json:"id,omitempty"`
+	Topics []string `json:"topics,omitempty"`
+}
+
+// reply is a message sent to a client, either in reply to a request or for an update of one of its topics.
+type reply struct {
+	Type   string          `json:"type"`
+	ID     string          `json:"id,omitempty"`
+	Topics []string        `json:"topics,omitempty"`
+	Topic  string          `json:"topic,omitempty"`
+	Data   json.RawMessage `json:"data,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+func (r reply) encode() []byte {
+	b, err := json.Marshal(r)
+	if err != nil {
+		// The replies only hold strings and already encoded JSON.
+		panic(err)
+	}
+
+	return b
+}
+
+// topic is a parsed topic, e.g. races.12 or prices.*.
+type topic struct {
+	kind string
+	id   int64
+	all  bool
+}
+
+func (t topic) String() string {
+	if t.all {
+		return t.kind + "." + wildcard
+	}
+
+	return t.kind + "." + strconv.FormatInt(t.id, 10)
+}
+
+// parseTopic parses a topic sent by a client.
+func parseTopic(value string) (topic, error) {
+	kind, id := value, ""
+	if i := strings.Index(value, "."); i >= 0 {
+		kind, id = value[:i], value[i+1:]
+	}
+
+	switch kind {
+	case kindRaces, kindPrices:
+		if id == wildcard {
+			return topic{kind: kind, all: true}, nil
+		}
+	case kindScores:
+	default:
+		return topic{}, fmt.Errorf("unknown topic %q, the topics are races.<race id>, prices.<race id> and scores.<event id>", value)
+	}
+
+	n, err := strconv.ParseInt(id, 10, 64)
+	if err != nil || n <= 0 {
+		return topic{}, fmt.Errorf("invalid topic %q, %s must be followed by an id", value, kind)
+	}
+
+	return topic{kind: kind, id: n}, nil
+}
+
+// parseTopics parses the topics of a request, without duplicates.
+func parseTopics(values []string) ([]topic, error) {
+	if len(values) == 0 {
+		return nil, errors.New("no topics given")
+	}
+
+	var topics []topic
+	seen := map[topic]bool{}
+
+	for _, value := range values {
+		t, err := parseTopic(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if !seen[t] {
+			topics = append(topics, t)
+			seen[t] = true
+		}
+	}
+
+	return topics, nil
+}
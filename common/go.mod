@@ -0,0 +1,14 @@
+module git.neds.sh/matty/entain/common
+
+go 1.16
+
+require (
+	github.com/prometheus/client_golang v1.11.0
+	github.com/sirupsen/logrus v1.8.0
+	go.opentelemetry.io/otel v1.0.1
+	go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc v1.0.1
+	go.opentelemetry.io/otel/exporters/stdout/stdouttrace v1.0.1
+	go.opentelemetry.io/otel/sdk v1.0.1
+	google.golang.org/genproto v0.0.0-20210831024726-fe130286e0e2
+	google.golang.org/grpc v1.41.0
+)
@@ -0,0 +1,153 @@
+// Package logging configures the logs of the racing and sports services and writes an access log line per gRPC request.
+//
+// The requests are logged with the request id the gateway forwards in the gRPC metadata, so that they can be matched
+// with the gateway's access log.
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// RequestIDMetadataKey is the metadata key the gateway forwards the request id with.
+	RequestIDMetadataKey = "x-request-id"
+
+	// SubjectMetadataKey is the metadata key the gateway forwards the verified subject of a bearer token with.
+	SubjectMetadataKey = "auth-subject"
+
+	// The metadata key the gateway forwards the owner of an API key with.
+	ownerMetadataKey = "api-key-owner"
+)
+
+// Configure sets the level (e.g. debug, info, warn) and the format (json or text) of the logs.
+func Configure(level string, format string) error {
+	lvl, err := log.ParseLevel(level)
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case "json":
+		log.SetFormatter(&log.JSONFormatter{})
+	case "text":
+		log.SetFormatter(&log.TextFormatter{FullTimestamp: true})
+	default:
+		return fmt.Errorf("invalid log format %q, expected json or text", format)
+	}
+
+	log.SetLevel(lvl)
+	log.SetOutput(os.Stdout)
+
+	return nil
+}
+
+// UnaryServerInterceptor logs the unary gRPC requests once they're done.
+func UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	done := logRequest(ctx, info.FullMethod)
+
+	resp, err := handler(ctx, req)
+	done(err)
+
+	return resp, err
+}
+
+// StreamServerInterceptor logs the streaming gRPC requests once they're done.
+func StreamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	done := logRequest(ss.Context(), info.FullMethod)
+
+	err := handler(srv, ss)
+	done(err)
+
+	return err
+}
+
+// logRequest starts timing a request. Requests that don't come through the gateway get a request id of their own.
+func logRequest(ctx context.Context, method string) func(err error) {
+	start := time.Now()
+
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	id := first(md, RequestIDMetadataKey)
+	if id == "" {
+		id = newRequestID()
+	}
+
+	caller := first(md, SubjectMetadataKey)
+	if caller == "" {
+		caller = first(md, ownerMetadataKey)
+	}
+	if caller == "" {
+		caller = "anonymous"
+	}
+
+	remoteAddr := ""
+	if p, ok := peer.FromContext(ctx); ok {
+		remoteAddr = p.Addr.String()
+	}
+
+	return func(err error) {
+		fields := log.Fields{
+			"method":      method,
+			"status":      code(err).String(),
+			"latency_ms":  float64(time.Since(start).Microseconds()) / 1000,
+			"request_id":  id,
+			"caller":      caller,
+			"remote_addr": remoteAddr,
+		}
+
+		if err != nil && code(err) != codes.Canceled {
+			fields["error"] = status.Convert(err).Message()
+		}
+
+		// The health checks of the gateway and the orchestrator would drown the rest.
+		if strings.HasPrefix(method, "/grpc.health.v1.Health/") {
+			log.WithFields(fields).Debug("request")
+			return
+		}
+
+		log.WithFields(fields).Info("request")
+	}
+}
+
+func first(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		log.Errorf("failed generating request id: %s", err)
+	}
+
+	return hex.EncodeToString(b)
+}
+
+// code returns the status code of a request, with the context errors mapped to their status codes like in the metrics.
+func code(err error) codes.Code {
+	switch {
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return codes.DeadlineExceeded
+	default:
+		return status.Code(err)
+	}
+}
@@ -0,0 +1,116 @@
+// Package metrics exposes the Prometheus metrics of the racing and sports services.
+//
+// The metric names and labels are the same in the API gateway and in the racing and sports services.
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	grpcRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "entain_grpc_requests_total",
+		Help: "Number of gRPC requests handled, by method and status code.",
+	}, []string{"method", "code"})
+
+	grpcRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "entain_grpc_request_duration_seconds",
+		Help:    "Duration of the gRPC requests, by method and status code. Streams are measured until they end.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "code"})
+
+	grpcRequestsInFlight = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "entain_grpc_requests_in_flight",
+		Help: "Number of gRPC requests being handled, by method.",
+	}, []string{"method"})
+
+	dbQueryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "entain_db_query_duration_seconds",
+		Help:    "Duration of the database queries, by repo and repo method.",
+		Buckets: prometheus.ExponentialBuckets(0.0005, 2, 12),
+	}, []string{"repo", "method"})
+)
+
+// UnaryServerInterceptor records the metrics of the unary gRPC requests.
+func UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	done := observeRequest(info.FullMethod)
+
+	resp, err := handler(ctx, req)
+	done(err)
+
+	return resp, err
+}
+
+// StreamServerInterceptor records the metrics of the streaming gRPC requests.
+func StreamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	done := observeRequest(info.FullMethod)
+
+	err := handler(srv, ss)
+	done(err)
+
+	return err
+}
+
+func observeRequest(method string) func(err error) {
+	start := time.Now()
+
+	inFlight := grpcRequestsInFlight.WithLabelValues(method)
+	inFlight.Inc()
+
+	return func(err error) {
+		inFlight.Dec()
+
+		code := code(err).String()
+		grpcRequests.WithLabelValues(method, code).Inc()
+		grpcRequestDuration.WithLabelValues(method, code).Observe(time.Since(start).Seconds())
+	}
+}
+
+// code returns the status code of a request. Handlers return the context errors as is when the client goes away,
+// e.g. when a stream is closed, so they're mapped to their status codes instead of Unknown.
+func code(err error) codes.Code {
+	switch {
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return codes.DeadlineExceeded
+	default:
+		return status.Code(err)
+	}
+}
+
+// ObserveQuery starts timing a database query of a repo method. Call the returned function once the query is done.
+//
+//	defer metrics.ObserveQuery("sports", "List")()
+func ObserveQuery(repo string, method string) func() {
+	start := time.Now()
+
+	return func() {
+		dbQueryDuration.WithLabelValues(repo, method).Observe(time.Since(start).Seconds())
+	}
+}
+
+// Serve serves the metrics at /metrics on the given endpoint in the background.
+func Serve(endpoint string) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	go func() {
+		log.Infof("metrics server listening on: %s", endpoint)
+
+		if err := http.ListenAndServe(endpoint, mux); err != nil {
+			log.Errorf("failed serving metrics: %s", err)
+		}
+	}()
+}
@@ -0,0 +1,174 @@
+// Package outrights stores the outright (futures) markets of the racing and sports services. An outright is on a race or
+// on a competition season, which is up to each service, while its selections and its settlement work the same way in both.
+package outrights
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+)
+
+// Columns are the columns Query reads after the ones of what the outrights are on.
+const Columns = `
+	outrights.name,
+	outrights.close_time,
+	COALESCE(outrights.winning_selection_id, 0),
+	COALESCE(outrights.settled_by, ''),
+	outrights.settled_at
+`
+
+// SettleQuery records the winning selection of an outright. The winning selection must belong to the outright and an
+// outright can only be settled once.
+const SettleQuery = `
+	UPDATE outrights
+	SET winning_selection_id = ?2, settled_by = ?3, settled_at = ?4
+	WHERE id = ?1
+		AND settled_at IS NULL
+		AND EXISTS (SELECT 1 FROM outright_selections WHERE outright_selections.id = ?2 AND outright_selections.outright_id = ?1)
+`
+
+// Outright is the part of an outright the services share.
+type Outright struct {
+	Id                 int64
+	Name               string
+	CloseTime          time.Time
+	WinningSelectionId int64
+	SettledBy          string
+	SettledAt          sql.NullTime
+	Selections         []Selection
+}
+
+// Selection is a runner or a team of an outright, with its price.
+type Selection struct {
+	Id    int64
+	Name  string
+	Price float64
+}
+
+// Status is OPEN until the close time of the outright, CLOSED after it and SETTLED once the winner is known.
+func (o *Outright) Status() string {
+	switch {
+	case o.SettledAt.Valid:
+		return "SETTLED"
+	case o.CloseTime.After(time.Now()):
+		return "OPEN"
+	default:
+		return "CLOSED"
+	}
+}
+
+// Result is WON or LOST once the outright is settled, and empty before.
+func (o *Outright) Result(selection Selection) string {
+	switch {
+	case !o.SettledAt.Valid:
+		return ""
+	case selection.Id == o.WinningSelectionId:
+		return "WON"
+	default:
+		return "LOST"
+	}
+}
+
+// CreateTables creates the outrights and the selections tables if they don't exist. The columns are the ones of what
+// the outrights are on, e.g. race_id INTEGER.
+func CreateTables(db *sql.DB, columns string) error {
+	statements := []string{
+		`CREATE TABLE IF NOT EXISTS outrights (id INTEGER PRIMARY KEY, ` + columns + `, name TEXT, close_time DATETIME, winning_selection_id INTEGER, settled_by TEXT, settled_at DATETIME)`,
+		`CREATE TABLE IF NOT EXISTS outright_selections (id INTEGER PRIMARY KEY, outright_id INTEGER, name TEXT, price REAL)`,
+	}
+
+	for _, statement := range statements {
+		if _, err := db.Exec(statement); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddSelections adds the selections of an outright.
+func AddSelections(db *sql.DB, outrightId int64, selections []Selection) error {
+	for _, selection := range selections {
+		if _, err := db.Exec(`INSERT INTO outright_selections (outright_id, name, price) VALUES (?, ?, ?)`, outrightId, selection.Name, selection.Price); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Query runs a query of the outrights and loads their selections, shortest price first. The query selects the id of the
+// outrights, then the columns of what they're on, then Columns. The former are scanned into the destinations returned
+// by on, which is called once per outright.
+func Query(ctx context.Context, db *sql.DB, query string, args []interface{}, on func() []interface{}) ([]*Outright, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		found []*Outright
+		ids   []interface{}
+		byId  = map[int64]*Outright{}
+	)
+
+	for rows.Next() {
+		var outright Outright
+
+		destinations := append([]interface{}{&outright.Id}, on()...)
+		destinations = append(destinations, &outright.Name, &outright.CloseTime, &outright.WinningSelectionId, &outright.SettledBy, &outright.SettledAt)
+
+		if err := rows.Scan(destinations...); err != nil {
+			return nil, err
+		}
+
+		found = append(found, &outright)
+		ids = append(ids, outright.Id)
+		byId[outright.Id] = &outright
+	}
+
+	if err := rows.Err(); err != nil || len(ids) == 0 {
+		return found, err
+	}
+
+	return found, loadSelections(ctx, db, byId, ids)
+}
+
+func loadSelections(ctx context.Context, db *sql.DB, outrights map[int64]*Outright, ids []interface{}) error {
+	rows, err := db.QueryContext(ctx, `SELECT id, outright_id, name, price FROM outright_selections WHERE outright_id IN (`+strings.Repeat("?,", len(ids)-1)+`?) ORDER BY price, name`, ids...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			selection  Selection
+			outrightId int64
+		)
+
+		if err := rows.Scan(&selection.Id, &outrightId, &selection.Name, &selection.Price); err != nil {
+			return err
+		}
+
+		outright := outrights[outrightId]
+		outright.Selections = append(outright.Selections, selection)
+	}
+
+	return rows.Err()
+}
+
+// Settle records the winning selection of an outright. It returns false if the outright was already settled or the
+// selection doesn't belong to it.
+func Settle(ctx context.Context, db *sql.DB, id int64, winningSelectionId int64, actor string) (bool, error) {
+	res, err := db.ExecContext(ctx, SettleQuery, id, winningSelectionId, actor, time.Now().UTC().Truncate(time.Second).Format(time.RFC3339))
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := res.RowsAffected()
+
+	return affected > 0, err
+}
@@ -0,0 +1,111 @@
+// Package rpcerror builds the gRPC errors of the racing and sports services, with the google.rpc details the gateway
+// renders, and hides the errors that aren't meant for the clients.
+package rpcerror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"git.neds.sh/matty/entain/common/logging"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// NotFound returns a NotFound error with a google.rpc.ResourceInfo detail naming the missing resource, e.g. racing.Race.
+func NotFound(resourceType string, id int64, format string, args ...interface{}) error {
+	message := fmt.Sprintf(format, args...)
+
+	st, err := status.New(codes.NotFound, message).WithDetails(&errdetails.ResourceInfo{
+		ResourceType: resourceType,
+		ResourceName: strconv.FormatInt(id, 10),
+		Description:  message,
+	})
+	if err != nil {
+		return status.Error(codes.NotFound, message)
+	}
+
+	return st.Err()
+}
+
+// Violations collects the invalid fields of a request, named by their proto path, e.g. filter.meeting_ids[0].
+type Violations []*errdetails.BadRequest_FieldViolation
+
+// Add adds an invalid field.
+func (v *Violations) Add(field string, format string, args ...interface{}) {
+	*v = append(*v, &errdetails.BadRequest_FieldViolation{Field: field, Description: fmt.Sprintf(format, args...)})
+}
+
+// Err returns an InvalidArgument error with a google.rpc.BadRequest detail listing the violations, or nil if there
+// are none.
+func (v Violations) Err() error {
+	if len(v) == 0 {
+		return nil
+	}
+
+	descriptions := make([]string, len(v))
+	for i, violation := range v {
+		descriptions[i] = violation.Description
+	}
+
+	message := strings.Join(descriptions, "; ")
+
+	st, err := status.New(codes.InvalidArgument, message).WithDetails(&errdetails.BadRequest{FieldViolations: v})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, message)
+	}
+
+	return st.Err()
+}
+
+// InvalidArgument returns an InvalidArgument error for a single invalid field.
+func InvalidArgument(field string, format string, args ...interface{}) error {
+	var v Violations
+	v.Add(field, format, args...)
+
+	return v.Err()
+}
+
+// UnaryServerInterceptor hides the errors that aren't gRPC statuses, e.g. the database errors, behind an Internal error
+// so that their details, e.g. the SQL, never reach the clients. They're logged instead.
+func UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+
+	return resp, toStatus(ctx, info.FullMethod, err)
+}
+
+// StreamServerInterceptor hides the errors of the streaming requests like UnaryServerInterceptor.
+func StreamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return toStatus(ss.Context(), info.FullMethod, handler(srv, ss))
+}
+
+func toStatus(ctx context.Context, method string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+
+	requestID := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(logging.RequestIDMetadataKey); len(values) > 0 {
+			requestID = values[0]
+		}
+	}
+
+	log.WithFields(log.Fields{"method": method, "request_id": requestID}).Errorf("internal error: %s", err)
+
+	return status.Error(codes.Internal, "internal error")
+}
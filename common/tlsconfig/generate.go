@@ -0,0 +1,229 @@
+package tlsconfig
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// The files written by Generate.
+const (
+	CAFile        = "ca.pem"
+	CAKeyFile     = "ca-key.pem"
+	ServerFile    = "server.pem"
+	ServerKeyFile = "server-key.pem"
+	ClientFile    = "client.pem"
+	ClientKeyFile = "client-key.pem"
+)
+
+const (
+	caName     = "Entain Development CA"
+	caValidity = 10 * 365 * 24 * time.Hour
+	// clientName is the common name of the client certificate, which is the gateway's.
+	clientName = "api"
+	// serialBits is the size of the random serial numbers of the certificates.
+	serialBits = 128
+)
+
+// Generate writes a CA, a server certificate for the hosts and a client certificate signed by the CA, with their keys,
+// to the directory, and returns the files it wrote. The hosts are names or IP addresses, e.g. localhost and 127.0.0.1.
+//
+// The CA already in the directory is kept, so that renewing the certificates doesn't change the CA the peers trust. The
+// certificates are for local development and tests, a real deployment gets its certificates from its own CA.
+func Generate(dir string, hosts []string, validity time.Duration) ([]string, error) {
+	if len(hosts) == 0 {
+		return nil, errors.New("the server certificate needs at least one host")
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	var written []string
+
+	ca, caKey, err := loadCA(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	if ca == nil {
+		if ca, caKey, err = newCA(); err != nil {
+			return nil, err
+		}
+
+		if err := writePair(dir, CAFile, CAKeyFile, ca.Raw, caKey); err != nil {
+			return nil, err
+		}
+
+		written = append(written, CAFile, CAKeyFile)
+	}
+
+	server := &x509.Certificate{
+		Subject:     pkix.Name{CommonName: hosts[0]},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	for _, host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			server.IPAddresses = append(server.IPAddresses, ip)
+		} else {
+			server.DNSNames = append(server.DNSNames, host)
+		}
+	}
+
+	client := &x509.Certificate{
+		Subject:     pkix.Name{CommonName: clientName},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	for _, leaf := range []struct {
+		template      *x509.Certificate
+		file, keyFile string
+	}{
+		{template: server, file: ServerFile, keyFile: ServerKeyFile},
+		{template: client, file: ClientFile, keyFile: ClientKeyFile},
+	} {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+
+		der, err := sign(leaf.template, validity, ca, key.Public(), caKey)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := writePair(dir, leaf.file, leaf.keyFile, der, key); err != nil {
+			return nil, err
+		}
+
+		written = append(written, leaf.file, leaf.keyFile)
+	}
+
+	for i, file := range written {
+		written[i] = filepath.Join(dir, file)
+	}
+
+	return written, nil
+}
+
+// loadCA loads the CA of the directory. It returns nil if there's none.
+func loadCA(dir string) (*x509.Certificate, crypto.Signer, error) {
+	certPEM, certErr := os.ReadFile(filepath.Join(dir, CAFile))
+	keyPEM, keyErr := os.ReadFile(filepath.Join(dir, CAKeyFile))
+
+	if os.IsNotExist(certErr) && os.IsNotExist(keyErr) {
+		return nil, nil, nil
+	}
+
+	if certErr != nil {
+		return nil, nil, certErr
+	}
+
+	if keyErr != nil {
+		return nil, nil, keyErr
+	}
+
+	certBlock, _ := pem.Decode(certPEM)
+	keyBlock, _ := pem.Decode(keyPEM)
+	if certBlock == nil || keyBlock == nil {
+		return nil, nil, fmt.Errorf("invalid CA in %s, expected PEM %s and %s", dir, CAFile, CAKeyFile)
+	}
+
+	cert, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(keyBlock.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok || !cert.IsCA {
+		return nil, nil, fmt.Errorf("invalid CA in %s, expected a CA certificate and its private key", dir)
+	}
+
+	return cert, signer, nil
+}
+
+func newCA() (*x509.Certificate, crypto.Signer, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	template := &x509.Certificate{
+		Subject:               pkix.Name{CommonName: caName},
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := sign(template, caValidity, template, key.Public(), key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cert, key, nil
+}
+
+// sign signs the certificate with the key of its parent, which is the certificate itself for the CA.
+func sign(template *x509.Certificate, validity time.Duration, parent *x509.Certificate, public crypto.PublicKey, key crypto.Signer) ([]byte, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), serialBits))
+	if err != nil {
+		return nil, err
+	}
+
+	template.SerialNumber = serial
+	template.NotBefore = time.Now().Add(-time.Minute)
+	template.NotAfter = time.Now().Add(validity)
+
+	if !template.IsCA {
+		template.KeyUsage = x509.KeyUsageDigitalSignature
+	}
+
+	return x509.CreateCertificate(rand.Reader, template, parent, public, key)
+}
+
+// writePair writes a certificate and its key. The files are written to temporary files and renamed, so that the servers
+// reloading them never read half written files.
+func writePair(dir string, file string, keyFile string, der []byte, key crypto.Signer) error {
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return err
+	}
+
+	if err := writeFile(filepath.Join(dir, keyFile), pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		return err
+	}
+
+	return writeFile(filepath.Join(dir, file), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o644)
+}
+
+func writeFile(path string, data []byte, perm os.FileMode) error {
+	tmp := path + ".tmp"
+
+	if err := os.WriteFile(tmp, data, perm); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,273 @@
+// Package tlsconfig configures TLS, and mutual TLS, from PEM files which are reloaded when they change on disk, so that
+// the certificates can be renewed without a restart.
+package tlsconfig
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/credentials"
+)
+
+// reloadInterval is how often the files are checked for changes, on the TLS handshakes.
+const reloadInterval = time.Second
+
+// Files are the PEM files of a TLS config.
+type Files struct {
+	// Cert is the certificate chain, along with its private Key.
+	Cert string
+	Key  string
+	// CA is the CA certificates the certificates of the peers are verified against.
+	CA string
+}
+
+// Server returns the TLS config of a server presenting the certificate of the files. When the files have a CA, the
+// clients must present a certificate it signed, i.e. mutual TLS.
+func Server(files Files) (*tls.Config, error) {
+	if files.Cert == "" || files.Key == "" {
+		return nil, errors.New("a TLS server needs both a certificate and a key")
+	}
+
+	r, err := newReloader(files)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return r.certificate(), nil
+		},
+	}
+
+	// The client certificates are verified against the current CA rather than the one loaded at start up.
+	if files.CA != "" {
+		config.ClientAuth = tls.RequireAnyClientCert
+		config.VerifyConnection = func(state tls.ConnectionState) error {
+			return r.verify(state.PeerCertificates, "", x509.ExtKeyUsageClientAuth)
+		}
+	}
+
+	return config, nil
+}
+
+// Client returns the gRPC credentials of a client presenting the certificate of the files, if any, for mutual TLS. The
+// server certificates are verified against the CA of the files, or the system ones when there's none, and the server
+// name, or the host of the address the client connects to when it's empty.
+func Client(files Files, serverName string) (credentials.TransportCredentials, error) {
+	if (files.Cert == "") != (files.Key == "") {
+		return nil, errors.New("a TLS client certificate needs a key, and the other way round")
+	}
+
+	r, err := newReloader(files)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &tls.Config{MinVersion: tls.VersionTLS12, ServerName: serverName}
+
+	if files.Cert != "" {
+		config.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return r.certificate(), nil
+		}
+	}
+
+	return &clientCredentials{TransportCredentials: credentials.NewTLS(config), config: config, r: r}, nil
+}
+
+// clientCredentials are the TLS credentials of a client verifying the server certificates against the current CA rather
+// than the one loaded at start up. crypto/tls does the verification itself, against the name of the address the client
+// connects to, be it a host name or an IP address.
+type clientCredentials struct {
+	credentials.TransportCredentials
+
+	config *tls.Config
+	r      *reloader
+}
+
+func (c *clientCredentials) ClientHandshake(ctx context.Context, authority string, conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	config := c.config.Clone()
+	config.RootCAs = c.r.rootCAs()
+
+	// The authority is the server name of the address, or the host and port of the target, which is left to
+	// credentials.NewTLS to trim.
+	return credentials.NewTLS(config).ClientHandshake(ctx, authority, conn)
+}
+
+func (c *clientCredentials) Clone() credentials.TransportCredentials {
+	config := c.config.Clone()
+
+	return &clientCredentials{TransportCredentials: credentials.NewTLS(config), config: config, r: c.r}
+}
+
+func (c *clientCredentials) OverrideServerName(serverName string) error {
+	c.config.ServerName = serverName
+
+	return c.TransportCredentials.OverrideServerName(serverName)
+}
+
+// reloader loads the files, and loads them again when they change. The previous certificates are kept when the new ones
+// can't be loaded, e.g. while they're being written.
+type reloader struct {
+	files Files
+
+	mu          sync.Mutex
+	cert        *tls.Certificate
+	roots       *x509.CertPool
+	modTimes    map[string]time.Time
+	lastChecked time.Time
+}
+
+func newReloader(files Files) (*reloader, error) {
+	r := &reloader{files: files}
+
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// rootCAs returns the CA certificates of the files, or nil for the system ones when there's none.
+func (r *reloader) rootCAs() *x509.CertPool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.reloadLocked()
+
+	return r.roots
+}
+
+func (r *reloader) certificate() *tls.Certificate {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.reloadLocked()
+
+	return r.cert
+}
+
+// verify verifies the certificate chain of a peer, and its name when one is given.
+func (r *reloader) verify(certs []*x509.Certificate, name string, usage x509.ExtKeyUsage) error {
+	r.mu.Lock()
+	r.reloadLocked()
+	roots := r.roots
+	r.mu.Unlock()
+
+	if len(certs) == 0 {
+		return errors.New("no certificate presented")
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	_, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		DNSName:       name,
+		KeyUsages:     []x509.ExtKeyUsage{usage},
+	})
+
+	return err
+}
+
+// reloadLocked loads the files again if one of them changed since they were last loaded, at most once per
+// reloadInterval. It must be called with the lock held.
+func (r *reloader) reloadLocked() {
+	if time.Since(r.lastChecked) < reloadInterval {
+		return
+	}
+
+	r.lastChecked = time.Now()
+
+	changed := false
+	for _, path := range r.paths() {
+		info, err := os.Stat(path)
+		if err != nil || !info.ModTime().Equal(r.modTimes[path]) {
+			changed = true
+			break
+		}
+	}
+
+	if !changed {
+		return
+	}
+
+	if err := r.loadLocked(); err != nil {
+		log.Errorf("failed reloading the TLS certificates, keeping the previous ones: %s", err)
+		return
+	}
+
+	log.Info("reloaded the TLS certificates")
+}
+
+func (r *reloader) load() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.lastChecked = time.Now()
+
+	return r.loadLocked()
+}
+
+// loadLocked loads the files. It must be called with the lock held.
+func (r *reloader) loadLocked() error {
+	modTimes := map[string]time.Time{}
+	for _, path := range r.paths() {
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+
+		modTimes[path] = info.ModTime()
+	}
+
+	var cert *tls.Certificate
+	if r.files.Cert != "" {
+		pair, err := tls.LoadX509KeyPair(r.files.Cert, r.files.Key)
+		if err != nil {
+			return err
+		}
+
+		cert = &pair
+	}
+
+	var roots *x509.CertPool
+	if r.files.CA != "" {
+		data, err := os.ReadFile(r.files.CA)
+		if err != nil {
+			return err
+		}
+
+		roots = x509.NewCertPool()
+		if !roots.AppendCertsFromPEM(data) {
+			return fmt.Errorf("no PEM certificates in CA file %s", r.files.CA)
+		}
+	}
+
+	r.cert, r.roots, r.modTimes = cert, roots, modTimes
+
+	return nil
+}
+
+func (r *reloader) paths() []string {
+	var paths []string
+
+	for _, path := range []string{r.files.Cert, r.files.Key, r.files.CA} {
+		if path != "" {
+			paths = append(paths, path)
+		}
+	}
+
+	return paths
+}
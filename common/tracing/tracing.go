@@ -0,0 +1,62 @@
+// Package tracing sets up OpenTelemetry tracing for the gateway and the racing and sports services.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+)
+
+// Init sets up the global tracer provider and the W3C trace context propagation.
+//
+// The spans are exported to the OTLP collector at endpoint over gRPC. When there's no endpoint, they're written as JSON to output,
+// which is a file, or dropped when it's empty or "none". They're never written to the standard output, which carries the JSON logs.
+// The returned function flushes the remaining spans and stops the exporter.
+func Init(ctx context.Context, service string, endpoint string, output string) (func(context.Context) error, error) {
+	exporter, err := newExporter(ctx, endpoint, output)
+	if err != nil {
+		return nil, err
+	}
+
+	var options []sdktrace.TracerProviderOption
+	if exporter != nil {
+		options = append(options, sdktrace.WithBatcher(exporter))
+	}
+
+	provider := sdktrace.NewTracerProvider(append(options,
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(service))),
+	)...)
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	return provider.Shutdown, nil
+}
+
+func newExporter(ctx context.Context, endpoint string, output string) (sdktrace.SpanExporter, error) {
+	if endpoint != "" {
+		return otlptracegrpc.New(ctx, otlptracegrpc.WithEndpoint(endpoint), otlptracegrpc.WithInsecure())
+	}
+
+	switch output {
+	case "", "none":
+		return nil, nil
+	case "stdout", "stderr":
+		return nil, fmt.Errorf("trace output %q is the log stream, give a file or none", output)
+	}
+
+	file, err := os.OpenFile(output, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	return stdouttrace.New(stdouttrace.WithWriter(file))
+}
@@ -0,0 +1,104 @@
+// Package venues is the catalogue of the venues shared by the racing and sports services.
+//
+// Both services seed the whole catalogue with the same ids, so that a venue id means the same venue in the races, the
+// sports events, GraphQL and the upcoming feed.
+package venues
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// FirstImportedId is the first id of the venues that aren't in the catalogue, i.e. the venues of the imported fixtures.
+// Only the sports service imports venues, so their ids don't clash with the ones of another service either.
+const FirstImportedId = 1000000
+
+// Venue is a venue of the catalogue.
+type Venue struct {
+	Id        int64
+	Name      string
+	City      string
+	Country   string
+	Latitude  float64
+	Longitude float64
+	// Timezone is an IANA time zone name, e.g. Australia/Melbourne.
+	Timezone string
+}
+
+// Racecourses are the venues the dummy race meetings take place at.
+var Racecourses = []Venue{
+	{Id: 1, Name: "Flemington", City: "Melbourne", Country: "AU", Latitude: -37.7886, Longitude: 144.9122, Timezone: "Australia/Melbourne"},
+	{Id: 2, Name: "Royal Randwick", City: "Sydney", Country: "AU", Latitude: -33.9099, Longitude: 151.2285, Timezone: "Australia/Sydney"},
+	{Id: 3, Name: "Eagle Farm", City: "Brisbane", Country: "AU", Latitude: -27.4330, Longitude: 153.0723, Timezone: "Australia/Brisbane"},
+	{Id: 4, Name: "Morphettville", City: "Adelaide", Country: "AU", Latitude: -34.9766, Longitude: 138.5404, Timezone: "Australia/Adelaide"},
+	{Id: 5, Name: "Caulfield", City: "Melbourne", Country: "AU", Latitude: -37.8815, Longitude: 145.0394, Timezone: "Australia/Melbourne"},
+	{Id: 6, Name: "Rosehill Gardens", City: "Sydney", Country: "AU", Latitude: -33.8245, Longitude: 151.0219, Timezone: "Australia/Sydney"},
+	{Id: 7, Name: "Ellerslie", City: "Auckland", Country: "NZ", Latitude: -36.8990, Longitude: 174.8086, Timezone: "Pacific/Auckland"},
+	{Id: 8, Name: "Sha Tin", City: "Hong Kong", Country: "HK", Latitude: 22.4005, Longitude: 114.2031, Timezone: "Asia/Hong_Kong"},
+	{Id: 9, Name: "Ascot", City: "Ascot", Country: "GB", Latitude: 51.4116, Longitude: -0.6757, Timezone: "Europe/London"},
+	{Id: 10, Name: "Churchill Downs", City: "Louisville", Country: "US", Latitude: 38.2037, Longitude: -85.7702, Timezone: "America/Kentucky/Louisville"},
+}
+
+// Stadiums are the venues the dummy sports events are spread across.
+var Stadiums = []Venue{
+	{Id: 11, Name: "Melbourne Cricket Ground", City: "Melbourne", Country: "AU", Latitude: -37.8200, Longitude: 144.9834, Timezone: "Australia/Melbourne"},
+	{Id: 12, Name: "Sydney Cricket Ground", City: "Sydney", Country: "AU", Latitude: -33.8917, Longitude: 151.2247, Timezone: "Australia/Sydney"},
+	{Id: 13, Name: "The Gabba", City: "Brisbane", Country: "AU", Latitude: -27.4858, Longitude: 153.0381, Timezone: "Australia/Brisbane"},
+	{Id: 14, Name: "Adelaide Oval", City: "Adelaide", Country: "AU", Latitude: -34.9156, Longitude: 138.5961, Timezone: "Australia/Adelaide"},
+	{Id: 15, Name: "Optus Stadium", City: "Perth", Country: "AU", Latitude: -31.9511, Longitude: 115.8890, Timezone: "Australia/Perth"},
+	{Id: 16, Name: "Eden Park", City: "Auckland", Country: "NZ", Latitude: -36.8750, Longitude: 174.7448, Timezone: "Pacific/Auckland"},
+	{Id: 17, Name: "Wembley Stadium", City: "London", Country: "GB", Latitude: 51.5560, Longitude: -0.2795, Timezone: "Europe/London"},
+	{Id: 18, Name: "Old Trafford", City: "Manchester", Country: "GB", Latitude: 53.4631, Longitude: -2.2913, Timezone: "Europe/London"},
+	{Id: 19, Name: "Camp Nou", City: "Barcelona", Country: "ES", Latitude: 41.3809, Longitude: 2.1228, Timezone: "Europe/Madrid"},
+	{Id: 20, Name: "Madison Square Garden", City: "New York", Country: "US", Latitude: 40.7505, Longitude: -73.9934, Timezone: "America/New_York"},
+}
+
+// Catalogue returns every venue of the catalogue.
+func Catalogue() []Venue {
+	return append(append([]Venue{}, Racecourses...), Stadiums...)
+}
+
+// ByName returns the venue of the catalogue with the given name, ignoring the case like the venues tables do.
+func ByName(name string) (Venue, bool) {
+	for _, venue := range Catalogue() {
+		if strings.EqualFold(venue.Name, name) {
+			return venue, true
+		}
+	}
+
+	return Venue{}, false
+}
+
+// LocalTime formats the time in the given time zone. It's empty if the time zone is empty or isn't known.
+func LocalTime(t time.Time, timezone string) string {
+	if timezone == "" {
+		return ""
+	}
+
+	location, err := loadLocation(timezone)
+	if err != nil {
+		return ""
+	}
+
+	return t.In(location).Format(time.RFC3339)
+}
+
+// locations caches the time zones by name, as loading one reads and parses its zoneinfo file.
+var locations sync.Map
+
+// loadLocation returns the time zone with the given name, loading it the first time only.
+func loadLocation(name string) (*time.Location, error) {
+	if location, ok := locations.Load(name); ok {
+		return location.(*time.Location), nil
+	}
+
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+
+	locations.Store(name, location)
+
+	return location, nil
+}
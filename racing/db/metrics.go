@@ -0,0 +1,30 @@
+package db
+
+import (
+	"context"
+
+	"git.neds.sh/matty/entain/common/metrics"
+	"git.neds.sh/matty/entain/racing/proto/racing"
+)
+
+// instrumentedRacesRepo records the duration of the queries of a RacesRepo.
+type instrumentedRacesRepo struct {
+	RacesRepo
+}
+
+// NewInstrumentedRacesRepo wraps a races repository to record the duration of its queries.
+func NewInstrumentedRacesRepo(repo RacesRepo) RacesRepo {
+	return &instrumentedRacesRepo{RacesRepo: repo}
+}
+
+func (r *instrumentedRacesRepo) List(ctx context.Context, filter *racing.ListRacesRequestFilter, orderBy *racing.ListRacesRequestOrderBy) ([]*racing.Race, error) {
+	defer metrics.ObserveQuery("races", "List")()
+
+	return r.RacesRepo.List(ctx, filter, orderBy)
+}
+
+func (r *instrumentedRacesRepo) GetRaceById(ctx context.Context, raceId int64) (*racing.Race, error) {
+	defer metrics.ObserveQuery("races", "GetRaceById")()
+
+	return r.RacesRepo.GetRaceById(ctx, raceId)
+}
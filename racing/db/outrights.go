@@ -0,0 +1,207 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"sync"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"git.neds.sh/matty/entain/common/outrights"
+	"git.neds.sh/matty/entain/racing/proto/racing"
+)
+
+// OutrightsRepo provides repository access to the outright (futures) markets of feature races.
+type OutrightsRepo interface {
+	// Init will initialise our outrights repository.
+	Init() error
+
+	// List returns the outrights matching the request, the ones closing first first.
+	List(ctx context.Context, in *racing.ListOutrightsRequest) ([]*racing.Outright, error)
+
+	// Get returns an outright by id. It'll be nil if the outright doesn't exist.
+	Get(ctx context.Context, id int64) (*racing.Outright, error)
+
+	// Settle records the winning selection of an outright. It returns false if the outright was already settled
+	// or the selection doesn't belong to it.
+	Settle(ctx context.Context, id int64, winningSelectionId int64, actor string) (bool, error)
+}
+
+type outrightsRepo struct {
+	db   *sql.DB
+	init sync.Once
+}
+
+// defaultOutrights are seeded for test/example purposes when there are no outrights.
+var defaultOutrights = []struct {
+	raceId     int64
+	name       string
+	selections []outrights.Selection
+}{
+	{1, "Melbourne Cup futures", []outrights.Selection{
+		{Name: "Incentivise", Price: 4},
+		{Name: "Verry Elleegant", Price: 6},
+		{Name: "Spanish Mission", Price: 8},
+		{Name: "Delphi", Price: 11},
+		{Name: "Twilight Payment", Price: 15},
+		{Name: "Tralee Rose", Price: 21},
+	}},
+	{2, "Cox Plate futures", []outrights.Selection{
+		{Name: "Zaaki", Price: 2.8},
+		{Name: "Anamoe", Price: 3.5},
+		{Name: "State Of Rest", Price: 5},
+		{Name: "Mo'unga", Price: 9},
+		{Name: "Profondo", Price: 15},
+	}},
+}
+
+// NewOutrightsRepo creates a new outrights repository.
+func NewOutrightsRepo(db *sql.DB) OutrightsRepo {
+	return &outrightsRepo{db: db}
+}
+
+// Init creates the outrights tables if they don't exist and seeds some dummy outrights.
+func (r *outrightsRepo) Init() error {
+	var err error
+
+	r.init.Do(func() {
+		err = outrights.CreateTables(r.db, "race_id INTEGER")
+		if err == nil {
+			err = r.seed()
+		}
+	})
+
+	return err
+}
+
+func (r *outrightsRepo) seed() error {
+	var count int64
+	if err := r.db.QueryRow(`SELECT COUNT(*) FROM outrights`).Scan(&count); err != nil || count > 0 {
+		return err
+	}
+
+	for _, outright := range defaultOutrights {
+		res, err := r.db.Exec(getOutrightQueries()[outrightInsert], outright.name, outright.raceId)
+		if err != nil {
+			return err
+		}
+
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		// The race may not exist in a database that wasn't seeded with the dummy races.
+		if affected == 0 {
+			continue
+		}
+
+		outrightId, err := res.LastInsertId()
+		if err != nil {
+			return err
+		}
+
+		if err := outrights.AddSelections(r.db, outrightId, outright.selections); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Get the outrights of the feature races
+func (r *outrightsRepo) List(ctx context.Context, in *racing.ListOutrightsRequest) ([]*racing.Outright, error) {
+	var (
+		clauses []string
+		args    []interface{}
+	)
+
+	if in.RaceId != 0 {
+		clauses = append(clauses, "outrights.race_id = ?")
+		args = append(args, in.RaceId)
+	}
+
+	if !in.IncludeSettled {
+		clauses = append(clauses, "outrights.settled_at IS NULL")
+	}
+
+	query := getOutrightQueries()[outrightsList]
+	if len(clauses) != 0 {
+		query += " WHERE " + strings.Join(clauses, " AND ")
+	}
+
+	return r.query(ctx, "outrights.List", query+" ORDER BY outrights.close_time, outrights.id", args...)
+}
+
+// Get an outright by id
+func (r *outrightsRepo) Get(ctx context.Context, id int64) (*racing.Outright, error) {
+	outrights, err := r.query(ctx, "outrights.Get", getOutrightQueries()[outrightsList]+" WHERE outrights.id = ?", id)
+	if err != nil || len(outrights) == 0 {
+		return nil, err
+	}
+
+	return outrights[0], nil
+}
+
+// Settle an outright with its winning selection
+func (r *outrightsRepo) Settle(ctx context.Context, id int64, winningSelectionId int64, actor string) (_ bool, err error) {
+	ctx, span := startQuerySpan(ctx, "outrights.Settle", outrights.SettleQuery)
+	defer func() { endQuerySpan(span, err) }()
+
+	return outrights.Settle(ctx, r.db, id, winningSelectionId, actor)
+}
+
+// query runs an outrights query, in a span with the given name.
+func (r *outrightsRepo) query(ctx context.Context, name string, query string, args ...interface{}) (_ []*racing.Outright, err error) {
+	ctx, span := startQuerySpan(ctx, name, "")
+	defer func() { endQuerySpan(span, err) }()
+
+	var found []*racing.Outright
+
+	rows, err := outrights.Query(ctx, r.db, query, args, func() []interface{} {
+		outright := &racing.Outright{}
+		found = append(found, outright)
+
+		return []interface{}{&outright.RaceId, &outright.Race}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, row := range rows {
+		if err := fromRow(found[i], row); err != nil {
+			return nil, err
+		}
+	}
+
+	return found, nil
+}
+
+// fromRow sets the shared part of an outright. The results of the selections are derived from the settlement.
+func fromRow(outright *racing.Outright, row *outrights.Outright) (err error) {
+	outright.Id, outright.Name, outright.Status = row.Id, row.Name, row.Status()
+
+	if outright.CloseTime, err = ptypes.TimestampProto(row.CloseTime); err != nil {
+		return err
+	}
+
+	if row.SettledAt.Valid {
+		outright.Settlement = &racing.OutrightSettlement{WinningSelectionId: row.WinningSelectionId, Actor: row.SettledBy}
+
+		if outright.Settlement.SettledAt, err = ptypes.TimestampProto(row.SettledAt.Time); err != nil {
+			return err
+		}
+	}
+
+	for _, selection := range row.Selections {
+		outright.Selections = append(outright.Selections, &racing.OutrightSelection{
+			Id:     selection.Id,
+			Name:   selection.Name,
+			Price:  selection.Price,
+			Result: row.Result(selection),
+		})
+	}
+
+	return nil
+}
@@ -1,5 +1,7 @@
 package db
 
+import "git.neds.sh/matty/entain/common/outrights"
+
 const (
 	racesList = "list"
 	raceById  = "tuple"
@@ -14,7 +16,8 @@ func getRaceQueries() map[string]string {
 				name, 
 				number, 
 				visible, 
-				advertised_start_time 
+				advertised_start_time,
+				COALESCE((SELECT venue_id FROM meetings WHERE meetings.id = races.meeting_id), 0) AS venue_id
 			FROM races
 		`,
 		raceById: `
@@ -24,9 +27,71 @@ func getRaceQueries() map[string]string {
 				name, 
 				number, 
 				visible, 
-				advertised_start_time 
+				advertised_start_time,
+				COALESCE((SELECT venue_id FROM meetings WHERE meetings.id = races.meeting_id), 0) AS venue_id
 			FROM races
 			WHERE id= ?
 		`,
 	}
 }
+
+const (
+	venuesByIds = "list"
+	venueSeed   = "seed"
+	meetingSeed = "seed-meeting"
+)
+
+func getVenueQueries() map[string]string {
+	return map[string]string{
+		venuesByIds: `
+			SELECT
+				id,
+				name,
+				COALESCE(city, ''),
+				COALESCE(country, ''),
+				COALESCE(latitude, 0),
+				COALESCE(longitude, 0),
+				COALESCE(timezone, '')
+			FROM venues
+		`,
+		// Seeding fills in the details of a venue that was created without them.
+		venueSeed: `
+			INSERT INTO venues (id, name, city, country, latitude, longitude, timezone)
+			VALUES (?, ?, ?, ?, ?, ?, ?)
+			ON CONFLICT (id) DO UPDATE SET
+				city = COALESCE(venues.city, excluded.city),
+				country = COALESCE(venues.country, excluded.country),
+				latitude = COALESCE(venues.latitude, excluded.latitude),
+				longitude = COALESCE(venues.longitude, excluded.longitude),
+				timezone = COALESCE(venues.timezone, excluded.timezone)
+		`,
+		meetingSeed: `
+			INSERT OR IGNORE INTO meetings (id, venue_id)
+			VALUES (?, ?)
+		`,
+	}
+}
+
+const (
+	outrightsList  = "list"
+	outrightInsert = "insert"
+)
+
+func getOutrightQueries() map[string]string {
+	return map[string]string{
+		outrightsList: `
+			SELECT
+				outrights.id,
+				outrights.race_id,
+				COALESCE(races.name, ''),
+				` + outrights.Columns + `
+			FROM outrights
+			LEFT JOIN races ON races.id = outrights.race_id
+		`,
+		// Outrights close when their race starts.
+		outrightInsert: `
+			INSERT INTO outrights (race_id, name, close_time)
+			SELECT id, ?, advertised_start_time FROM races WHERE id = ?
+		`,
+	}
+}
@@ -1,6 +1,7 @@
 package db
 
 import (
+	"context"
 	"database/sql"
 	"strconv"
 	"strings"
@@ -19,10 +20,10 @@ type RacesRepo interface {
 	Init() error
 
 	// List will return a list of races.
-	List(filter *racing.ListRacesRequestFilter, order_by *racing.ListRacesRequestOrderBy) ([]*racing.Race, error)
+	List(ctx context.Context, filter *racing.ListRacesRequestFilter, order_by *racing.ListRacesRequestOrderBy) ([]*racing.Race, error)
 
 	// Get race details by id
-	GetRaceById(raceId int64) (*racing.Race, error)
+	GetRaceById(ctx context.Context, raceId int64) (*racing.Race, error)
 }
 
 type racesRepo struct {
@@ -42,12 +43,15 @@ func (r *racesRepo) Init() error {
 	r.init.Do(func() {
 		// For test/example purposes, we seed the DB with some dummy races.
 		err = r.seed()
+		if err == nil {
+			err = r.seedVenues()
+		}
 	})
 
 	return err
 }
 
-func (r *racesRepo) List(filter *racing.ListRacesRequestFilter, orderBy *racing.ListRacesRequestOrderBy) ([]*racing.Race, error) {
+func (r *racesRepo) List(ctx context.Context, filter *racing.ListRacesRequestFilter, orderBy *racing.ListRacesRequestOrderBy) ([]*racing.Race, error) {
 	var (
 		err   error
 		query string
@@ -59,17 +63,23 @@ func (r *racesRepo) List(filter *racing.ListRacesRequestFilter, orderBy *racing.
 	query, args = r.applyFilter(query, filter)
 	query = r.applyOrderByClause(query, orderBy)
 
-	rows, err := r.db.Query(query, args...)
+	ctx, span := startQuerySpan(ctx, "races.List", query)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
 
 	if err != nil {
+		endQuerySpan(span, err)
 		return nil, err
 	}
 
-	return r.scanRaces(rows)
+	races, err := r.scanRaces(ctx, rows)
+	endQuerySpan(span, err)
+
+	return races, err
 }
 
 // Get a single race by id
-func (r *racesRepo) GetRaceById(raceId int64) (*racing.Race, error) {
+func (r *racesRepo) GetRaceById(ctx context.Context, raceId int64) (*racing.Race, error) {
 	var (
 		query string
 		args  []interface{}
@@ -79,9 +89,14 @@ func (r *racesRepo) GetRaceById(raceId int64) (*racing.Race, error) {
 
 	args = append(args, raceId)
 
-	row := r.db.QueryRow(query, args...)
+	ctx, span := startQuerySpan(ctx, "races.GetRaceById", query)
+
+	row := r.db.QueryRowContext(ctx, query, args...)
 
-	return r.scanRace(row)
+	race, err := r.scanRace(ctx, row)
+	endQuerySpan(span, err)
+
+	return race, err
 }
 
 func (r *racesRepo) applyFilter(query string, filter *racing.ListRacesRequestFilter) (string, []interface{}) {
@@ -106,6 +121,20 @@ func (r *racesRepo) applyFilter(query string, filter *racing.ListRacesRequestFil
 		clauses = append(clauses, "visible = "+strconv.FormatBool(*filter.MeetingVisibility))
 	}
 
+	if len(filter.VenueIds) > 0 {
+		clauses = append(clauses, "meeting_id IN (SELECT id FROM meetings WHERE venue_id IN ("+strings.Repeat("?,", len(filter.VenueIds)-1)+"?))")
+
+		for _, venueId := range filter.VenueIds {
+			args = append(args, venueId)
+		}
+	}
+
+	// Countries are stored as upper case ISO 3166-1 alpha-2 codes.
+	if filter.Country != "" {
+		clauses = append(clauses, "meeting_id IN (SELECT meetings.id FROM meetings JOIN venues ON venues.id = meetings.venue_id WHERE venues.country = ?)")
+		args = append(args, strings.ToUpper(strings.TrimSpace(filter.Country)))
+	}
+
 	if len(clauses) != 0 {
 		query += " WHERE " + strings.Join(clauses, " AND ")
 	}
@@ -114,6 +143,7 @@ func (r *racesRepo) applyFilter(query string, filter *racing.ListRacesRequestFil
 }
 
 func (m *racesRepo) scanRaces(
+	ctx context.Context,
 	rows *sql.Rows,
 ) ([]*racing.Race, error) {
 	var races []*racing.Race
@@ -121,8 +151,9 @@ func (m *racesRepo) scanRaces(
 	for rows.Next() {
 		var race racing.Race
 		var advertisedStart time.Time
+		var venueId int64
 
-		if err := rows.Scan(&race.Id, &race.MeetingId, &race.Name, &race.Number, &race.Visible, &advertisedStart); err != nil {
+		if err := rows.Scan(&race.Id, &race.MeetingId, &race.Name, &race.Number, &race.Visible, &advertisedStart, &venueId); err != nil {
 			if err == sql.ErrNoRows {
 				return nil, nil
 			}
@@ -144,20 +175,35 @@ func (m *racesRepo) scanRaces(
 			race.Status = "CLOSED"
 		}
 
+		if venueId != 0 {
+			race.Venue = &racing.Venue{Id: venueId}
+		}
+
 		races = append(races, &race)
 	}
 
+	// The errors of the query, e.g. a missing table, only show up once the rows are read.
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := m.setVenues(ctx, races); err != nil {
+		return nil, err
+	}
+
 	return races, nil
 }
 
 // This will try to read the record from the DB and if successful it'll also set the race status. Otherwise it'll return an error
 func (m *racesRepo) scanRace(
+	ctx context.Context,
 	row *sql.Row,
 ) (*racing.Race, error) {
 	var race racing.Race
 	var advertisedStart time.Time
+	var venueId int64
 
-	if err := row.Scan(&race.Id, &race.MeetingId, &race.Name, &race.Number, &race.Visible, &advertisedStart); err != nil {
+	if err := row.Scan(&race.Id, &race.MeetingId, &race.Name, &race.Number, &race.Visible, &advertisedStart, &venueId); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
 		}
@@ -179,14 +225,24 @@ func (m *racesRepo) scanRace(
 		race.Status = "CLOSED"
 	}
 
+	if venueId != 0 {
+		race.Venue = &racing.Venue{Id: venueId}
+	}
+
+	if err := m.setVenues(ctx, []*racing.Race{&race}); err != nil {
+		return nil, err
+	}
+
 	return &race, nil
 }
 
+// RaceOrderByFields are the columns the races can be ordered by.
+var RaceOrderByFields = []string{"id", "meeting_id", "name", "number", "visible", "advertised_start_time"}
+
 /* This will add an ORDER BY clause to the ListRaces query with the fileds specified in the request and their order by direction
 
-NOTE: It's better to return an error if the client sends an invalid column name in the order by expression.
-		Therefore the validity of the column name is not checked while adding the order by clause.
-		And query execution will fail if atleast one of the order by expressions have an invalid column name.
+NOTE: The fields aren't checked here, the service rejects the ones not in RaceOrderByFields before the query is built.
+		Otherwise the query would fail with an SQL error, which isn't meant for the clients.
 */
 func (r *racesRepo) applyOrderByClause(query string, orderBy *racing.ListRacesRequestOrderBy) string {
 	var expressions []string
@@ -0,0 +1,36 @@
+package db
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("git.neds.sh/matty/entain/racing/db")
+
+// startQuerySpan starts the span of a repo method running the given query. The statement is recorded without its arguments,
+// i.e. it's the shape of the query, so that it's safe to export and the spans of the same query can be grouped. The
+// methods running several statements, e.g. in a transaction, give an empty query and have no statement recorded.
+func startQuerySpan(ctx context.Context, name string, query string) (context.Context, trace.Span) {
+	attributes := []attribute.KeyValue{semconv.DBSystemSqlite}
+	if query != "" {
+		attributes = append(attributes, semconv.DBStatementKey.String(strings.Join(strings.Fields(query), " ")))
+	}
+
+	return tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attributes...))
+}
+
+// endQuerySpan ends the span of a repo method, recording the error if it failed.
+func endQuerySpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
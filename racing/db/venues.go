@@ -0,0 +1,101 @@
+package db
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"git.neds.sh/matty/entain/common/venues"
+	"git.neds.sh/matty/entain/racing/proto/racing"
+)
+
+// seedVenues creates the venues and meetings tables and seeds them with the shared venues catalogue, with one dummy race
+// meeting per racecourse.
+func (r *racesRepo) seedVenues() error {
+	statements := []string{
+		`CREATE TABLE IF NOT EXISTS venues (id INTEGER PRIMARY KEY, name TEXT UNIQUE COLLATE NOCASE, city TEXT, country TEXT, latitude REAL, longitude REAL, timezone TEXT)`,
+		`CREATE TABLE IF NOT EXISTS meetings (id INTEGER PRIMARY KEY, venue_id INTEGER)`,
+	}
+
+	for _, statement := range statements {
+		if _, err := r.db.Exec(statement); err != nil {
+			return err
+		}
+	}
+
+	for _, venue := range venues.Catalogue() {
+		if _, err := r.db.Exec(getVenueQueries()[venueSeed], venue.Id, venue.Name, venue.City, venue.Country, venue.Latitude, venue.Longitude, venue.Timezone); err != nil {
+			return err
+		}
+	}
+
+	for i, venue := range venues.Racecourses {
+		if _, err := r.db.Exec(getVenueQueries()[meetingSeed], i+1, venue.Id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setVenues replaces the venue ids scanned into the given races with the venue details and sets their local advertised start time.
+// The venues are fetched in a single query.
+func (r *racesRepo) setVenues(ctx context.Context, races []*racing.Race) error {
+	var (
+		args []interface{}
+		seen = map[int64]bool{}
+	)
+
+	for _, race := range races {
+		if race.Venue != nil && !seen[race.Venue.Id] {
+			seen[race.Venue.Id] = true
+			args = append(args, race.Venue.Id)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	rows, err := r.db.QueryContext(ctx, getVenueQueries()[venuesByIds]+" WHERE id IN ("+strings.Repeat("?,", len(args)-1)+"?)", args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	byId := map[int64]*racing.Venue{}
+
+	for rows.Next() {
+		var venue racing.Venue
+
+		if err := rows.Scan(&venue.Id, &venue.Name, &venue.City, &venue.Country, &venue.Latitude, &venue.Longitude, &venue.Timezone); err != nil {
+			return err
+		}
+
+		byId[venue.Id] = &venue
+	}
+
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, race := range races {
+		if race.Venue == nil {
+			continue
+		}
+
+		race.Venue = byId[race.Venue.Id]
+		race.AdvertisedStartLocalTime = localTime(race.AdvertisedStartTime.AsTime(), race.Venue)
+	}
+
+	return nil
+}
+
+// localTime formats the time in the time zone of the venue. It's empty if the venue or its time zone isn't known.
+func localTime(t time.Time, venue *racing.Venue) string {
+	if venue == nil {
+		return ""
+	}
+
+	return venues.LocalTime(t, venue.Timezone)
+}
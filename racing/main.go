@@ -1,31 +1,58 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"flag"
 	"net"
+	"time"
+	// The time zone database is embedded so that the local start times of races can be shown on hosts without one.
+	_ "time/tzdata"
 
+	"git.neds.sh/matty/entain/common/logging"
+	"git.neds.sh/matty/entain/common/metrics"
+	"git.neds.sh/matty/entain/common/rpcerror"
+	"git.neds.sh/matty/entain/common/tlsconfig"
+	"git.neds.sh/matty/entain/common/tracing"
 	"git.neds.sh/matty/entain/racing/db"
 	"git.neds.sh/matty/entain/racing/proto/racing"
 	"git.neds.sh/matty/entain/racing/service"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 var (
-	grpcEndpoint = flag.String("grpc-racing-endpoint", "localhost:9000", "gRPC server endpoint")
+	grpcEndpoint        = flag.String("grpc-racing-endpoint", ":9000", "gRPC server endpoint, e.g. :9010 to run another instance on the same host")
+	healthCheckInterval = flag.Duration("health-check-interval", 5*time.Second, "How often the database is checked for the gRPC health service")
+	metricsEndpoint     = flag.String("metrics-endpoint", "localhost:9100", "Endpoint the Prometheus metrics are served on at /metrics. Metrics aren't served when it's empty")
+	otlpEndpoint        = flag.String("otlp-endpoint", "", "Endpoint of the OTLP collector the traces are exported to over gRPC, e.g. localhost:4317")
+	traceOutput         = flag.String("trace-output", "none", "File the traces are written to when there's no OTLP collector, or none to drop them")
+	tlsCert             = flag.String("tls-cert", "", "PEM certificate chain of the gRPC server, reloaded when it changes. The server doesn't use TLS when it's not set")
+	tlsKey              = flag.String("tls-key", "", "PEM private key of -tls-cert, reloaded when it changes")
+	tlsClientCA         = flag.String("tls-client-ca", "", "PEM CA certificates the client certificates are verified against, which are then required (mutual TLS)")
+	logLevel            = flag.String("log-level", "info", "Level of the logs: debug, info, warn or error")
+	logFormat           = flag.String("log-format", "json", "Format of the logs: json or text")
+	watchInterval       = flag.Duration("watch-interval", time.Second, "How often the races are polled for the status changes streamed by WatchRaces")
 )
 
 func main() {
 	flag.Parse()
 
+	if err := logging.Configure(*logLevel, *logFormat); err != nil {
+		log.Fatalf("failed configuring logs: %s", err)
+	}
+
 	if err := run(); err != nil {
 		log.Fatalf("failed running grpc server: %s", err)
 	}
 }
 
 func run() error {
-	conn, err := net.Listen("tcp", ":9000")
+	conn, err := net.Listen("tcp", *grpcEndpoint)
 	if err != nil {
 		return err
 	}
@@ -35,20 +62,69 @@ func run() error {
 		return err
 	}
 
-	racesRepo := db.NewRacesRepo(racingDB)
+	racesRepo := db.NewInstrumentedRacesRepo(db.NewRacesRepo(racingDB))
 	if err := racesRepo.Init(); err != nil {
 		return err
 	}
 
-	grpcServer := grpc.NewServer()
+	outrightsRepo := db.NewOutrightsRepo(racingDB)
+	if err := outrightsRepo.Init(); err != nil {
+		return err
+	}
+
+	shutdownTracing, err := tracing.Init(context.Background(), "racing", *otlpEndpoint, *traceOutput)
+	if err != nil {
+		return err
+	}
+	defer shutdownTracing(context.Background())
+
+	// The tracing interceptors continue the traces of the gateway, whose context comes in the gRPC metadata. The error
+	// ones are last so that the errors are logged and counted with the status the clients get.
+	serverOptions := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(otelgrpc.UnaryServerInterceptor(), logging.UnaryServerInterceptor, metrics.UnaryServerInterceptor, rpcerror.UnaryServerInterceptor),
+		grpc.ChainStreamInterceptor(otelgrpc.StreamServerInterceptor(), logging.StreamServerInterceptor, metrics.StreamServerInterceptor, rpcerror.StreamServerInterceptor),
+	}
+
+	// The server uses TLS when it has a certificate, and mutual TLS when it has a client CA as well.
+	if *tlsCert != "" {
+		config, err := tlsconfig.Server(tlsconfig.Files{Cert: *tlsCert, Key: *tlsKey, CA: *tlsClientCA})
+		if err != nil {
+			return err
+		}
+
+		serverOptions = append(serverOptions, grpc.Creds(credentials.NewTLS(config)))
+	}
+
+	grpcServer := grpc.NewServer(serverOptions...)
+
+	raceWatcher := service.NewRaceWatcher(racesRepo, outrightsRepo)
 
 	racing.RegisterRacingServer(
 		grpcServer,
 		service.NewRacingService(
 			racesRepo,
+			outrightsRepo,
+			raceWatcher,
 		),
 	)
 
+	// The health service is NOT_SERVING while the database can't be queried.
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	healthChecker := service.NewHealthChecker(healthServer, func(ctx context.Context) error {
+		return db.Ping(ctx, racingDB)
+	}, "racing.Racing")
+	go healthChecker.Run(ctx, *healthCheckInterval)
+	go raceWatcher.Run(ctx, *watchInterval)
+
+	if *metricsEndpoint != "" {
+		metrics.Serve(*metricsEndpoint)
+	}
+
 	log.Infof("gRPC server listening on: %s", *grpcEndpoint)
 
 	if err := grpcServer.Serve(conn); err != nil {
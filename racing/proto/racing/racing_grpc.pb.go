@@ -22,6 +22,14 @@ type RacingClient interface {
 	ListRaces(ctx context.Context, in *ListRacesRequest, opts ...grpc.CallOption) (*ListRacesResponse, error)
 	// Get race details by id
 	GetRaceById(ctx context.Context, in *GetRaceRequest, opts ...grpc.CallOption) (*GetRaceResponse, error)
+	// ListOutrights returns the outright (futures) markets of feature races
+	ListOutrights(ctx context.Context, in *ListOutrightsRequest, opts ...grpc.CallOption) (*ListOutrightsResponse, error)
+	// GetOutright returns an outright market with its selections and prices
+	GetOutright(ctx context.Context, in *GetOutrightRequest, opts ...grpc.CallOption) (*GetOutrightResponse, error)
+	// SettleOutright settles a closed outright market with its winning selection
+	SettleOutright(ctx context.Context, in *SettleOutrightRequest, opts ...grpc.CallOption) (*SettleOutrightResponse, error)
+	// WatchRaces streams the live updates of races: their status changes and the changes of their outright markets
+	WatchRaces(ctx context.Context, in *WatchRacesRequest, opts ...grpc.CallOption) (Racing_WatchRacesClient, error)
 }
 
 type racingClient struct {
@@ -50,6 +58,65 @@ func (c *racingClient) GetRaceById(ctx context.Context, in *GetRaceRequest, opts
 	return out, nil
 }
 
+func (c *racingClient) ListOutrights(ctx context.Context, in *ListOutrightsRequest, opts ...grpc.CallOption) (*ListOutrightsResponse, error) {
+	out := new(ListOutrightsResponse)
+	err := c.cc.Invoke(ctx, "/racing.Racing/ListOutrights", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *racingClient) GetOutright(ctx context.Context, in *GetOutrightRequest, opts ...grpc.CallOption) (*GetOutrightResponse, error) {
+	out := new(GetOutrightResponse)
+	err := c.cc.Invoke(ctx, "/racing.Racing/GetOutright", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *racingClient) SettleOutright(ctx context.Context, in *SettleOutrightRequest, opts ...grpc.CallOption) (*SettleOutrightResponse, error) {
+	out := new(SettleOutrightResponse)
+	err := c.cc.Invoke(ctx, "/racing.Racing/SettleOutright", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *racingClient) WatchRaces(ctx context.Context, in *WatchRacesRequest, opts ...grpc.CallOption) (Racing_WatchRacesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Racing_ServiceDesc.Streams[0], "/racing.Racing/WatchRaces", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &racingWatchRacesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Racing_WatchRacesClient interface {
+	Recv() (*RaceUpdate, error)
+	grpc.ClientStream
+}
+
+type racingWatchRacesClient struct {
+	grpc.ClientStream
+}
+
+func (x *racingWatchRacesClient) Recv() (*RaceUpdate, error) {
+	m := new(RaceUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // RacingServer is the server API for Racing service.
 // All implementations should embed UnimplementedRacingServer
 // for forward compatibility
@@ -58,6 +125,14 @@ type RacingServer interface {
 	ListRaces(context.Context, *ListRacesRequest) (*ListRacesResponse, error)
 	// Get race details by id
 	GetRaceById(context.Context, *GetRaceRequest) (*GetRaceResponse, error)
+	// ListOutrights returns the outright (futures) markets of feature races
+	ListOutrights(context.Context, *ListOutrightsRequest) (*ListOutrightsResponse, error)
+	// GetOutright returns an outright market with its selections and prices
+	GetOutright(context.Context, *GetOutrightRequest) (*GetOutrightResponse, error)
+	// SettleOutright settles a closed outright market with its winning selection
+	SettleOutright(context.Context, *SettleOutrightRequest) (*SettleOutrightResponse, error)
+	// WatchRaces streams the live updates of races: their status changes and the changes of their outright markets
+	WatchRaces(*WatchRacesRequest, Racing_WatchRacesServer) error
 }
 
 // UnimplementedRacingServer should be embedded to have forward compatible implementations.
@@ -70,6 +145,18 @@ func (UnimplementedRacingServer) ListRaces(context.Context, *ListRacesRequest) (
 func (UnimplementedRacingServer) GetRaceById(context.Context, *GetRaceRequest) (*GetRaceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRaceById not implemented")
 }
+func (UnimplementedRacingServer) ListOutrights(context.Context, *ListOutrightsRequest) (*ListOutrightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOutrights not implemented")
+}
+func (UnimplementedRacingServer) GetOutright(context.Context, *GetOutrightRequest) (*GetOutrightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOutright not implemented")
+}
+func (UnimplementedRacingServer) SettleOutright(context.Context, *SettleOutrightRequest) (*SettleOutrightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleOutright not implemented")
+}
+func (UnimplementedRacingServer) WatchRaces(*WatchRacesRequest, Racing_WatchRacesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchRaces not implemented")
+}
 
 // UnsafeRacingServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to RacingServer will
@@ -118,6 +205,81 @@ func _Racing_GetRaceById_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Racing_ListOutrights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOutrightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RacingServer).ListOutrights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racing.Racing/ListOutrights",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RacingServer).ListOutrights(ctx, req.(*ListOutrightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Racing_GetOutright_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOutrightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RacingServer).GetOutright(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racing.Racing/GetOutright",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RacingServer).GetOutright(ctx, req.(*GetOutrightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Racing_SettleOutright_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettleOutrightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RacingServer).SettleOutright(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racing.Racing/SettleOutright",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RacingServer).SettleOutright(ctx, req.(*SettleOutrightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Racing_WatchRaces_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRacesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RacingServer).WatchRaces(m, &racingWatchRacesServer{stream})
+}
+
+type Racing_WatchRacesServer interface {
+	Send(*RaceUpdate) error
+	grpc.ServerStream
+}
+
+type racingWatchRacesServer struct {
+	grpc.ServerStream
+}
+
+func (x *racingWatchRacesServer) Send(m *RaceUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Racing_ServiceDesc is the grpc.ServiceDesc for Racing service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -133,7 +295,25 @@ var Racing_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRaceById",
 			Handler:    _Racing_GetRaceById_Handler,
 		},
+		{
+			MethodName: "ListOutrights",
+			Handler:    _Racing_ListOutrights_Handler,
+		},
+		{
+			MethodName: "GetOutright",
+			Handler:    _Racing_GetOutright_Handler,
+		},
+		{
+			MethodName: "SettleOutright",
+			Handler:    _Racing_SettleOutright_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchRaces",
+			Handler:       _Racing_WatchRaces_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "racing/racing.proto",
 }
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"sync"
+
+	"git.neds.sh/matty/entain/racing/proto/racing"
+)
+
+// subscriberBufferSize is the number of updates that can be queued for a subscriber before it's considered too slow.
+const subscriberBufferSize = 64
+
+// broker fans out the live updates of races to the streaming subscribers within this process.
+type broker struct {
+	mu          sync.Mutex
+	subscribers map[chan *racing.RaceUpdate]struct{}
+}
+
+func newBroker() *broker {
+	return &broker{subscribers: map[chan *racing.RaceUpdate]struct{}{}}
+}
+
+// subscribe returns a channel receiving the updates of all the races and a function to stop the subscription.
+// The channel is closed when the subscription stops, including when the subscriber falls too far behind.
+func (b *broker) subscribe() (<-chan *racing.RaceUpdate, func()) {
+	ch := make(chan *racing.RaceUpdate, subscriberBufferSize)
+
+	b.mu.Lock()
+	b.subscribers[ch] = struct{}{}
+	b.mu.Unlock()
+
+	return ch, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		b.remove(ch)
+	}
+}
+
+// publish sends the update to all the subscribers without blocking. Subscribers that can't keep up are dropped.
+func (b *broker) publish(update *racing.RaceUpdate) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subscribers {
+		select {
+		case ch <- update:
+		default:
+			b.remove(ch)
+		}
+	}
+}
+
+// remove must be called with the lock held.
+func (b *broker) remove(ch chan *racing.RaceUpdate) {
+	if _, ok := b.subscribers[ch]; !ok {
+		return
+	}
+
+	delete(b.subscribers, ch)
+	close(ch)
+}
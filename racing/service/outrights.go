@@ -0,0 +1,102 @@
+package service
+
+import (
+	"strings"
+
+	"git.neds.sh/matty/entain/common/rpcerror"
+	"git.neds.sh/matty/entain/racing/proto/racing"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Get the outright markets of feature races
+func (s *racingService) ListOutrights(ctx context.Context, in *racing.ListOutrightsRequest) (*racing.ListOutrightsResponse, error) {
+	outrights, err := s.outrightsRepo.List(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &racing.ListOutrightsResponse{Outrights: outrights}, nil
+}
+
+// Get an outright market by id
+func (s *racingService) GetOutright(ctx context.Context, in *racing.GetOutrightRequest) (*racing.GetOutrightResponse, error) {
+	outright, err := s.getOutright(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &racing.GetOutrightResponse{Outright: outright}, nil
+}
+
+// Settle a closed outright market with its winning selection
+func (s *racingService) SettleOutright(ctx context.Context, in *racing.SettleOutrightRequest) (*racing.SettleOutrightResponse, error) {
+	actor := strings.TrimSpace(in.Actor)
+	if actor == "" {
+		return nil, rpcerror.InvalidArgument("actor", "actor is required")
+	}
+
+	outright, err := s.getOutright(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasSelection(outright, in.WinningSelectionId) {
+		return nil, rpcerror.InvalidArgument("winning_selection_id", "selection %d is not a selection of outright %d", in.WinningSelectionId, in.Id)
+	}
+
+	switch outright.Status {
+	case "OPEN":
+		return nil, status.Errorf(codes.FailedPrecondition, "outright %d is still open, it can only be settled once it closes", in.Id)
+	case "SETTLED":
+		return nil, status.Errorf(codes.FailedPrecondition, "outright %d is already settled", in.Id)
+	}
+
+	settled, err := s.outrightsRepo.Settle(ctx, in.Id, in.WinningSelectionId, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	// Someone else settled it in the meantime.
+	if !settled {
+		return nil, status.Errorf(codes.FailedPrecondition, "outright %d is already settled", in.Id)
+	}
+
+	outright, err = s.outrightsRepo.Get(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.watcher.publishOutright(outright)
+
+	return &racing.SettleOutrightResponse{Outright: outright}, nil
+}
+
+// getOutright returns the outright with the given id, or an InvalidArgument or a NotFound error.
+func (s *racingService) getOutright(ctx context.Context, id int64) (*racing.Outright, error) {
+	if id <= 0 {
+		return nil, rpcerror.InvalidArgument("id", "id must be positive, got %d", id)
+	}
+
+	outright, err := s.outrightsRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if outright == nil {
+		return nil, rpcerror.NotFound("racing.Outright", id, "outright %d not found", id)
+	}
+
+	return outright, nil
+}
+
+func hasSelection(outright *racing.Outright, selectionId int64) bool {
+	for _, selection := range outright.Selections {
+		if selection.Id == selectionId {
+			return true
+		}
+	}
+
+	return false
+}
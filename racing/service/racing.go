@@ -1,6 +1,10 @@
 package service
 
 import (
+	"fmt"
+	"strings"
+
+	"git.neds.sh/matty/entain/common/rpcerror"
 	"git.neds.sh/matty/entain/racing/db"
 	"git.neds.sh/matty/entain/racing/proto/racing"
 	"golang.org/x/net/context"
@@ -12,21 +16,39 @@ type Racing interface {
 
 	// Get race details by id
 	GetRaceById(ctx context.Context, in *racing.GetRaceRequest) (*racing.GetRaceResponse, error)
+
+	// ListOutrights will return the outright markets of feature races.
+	ListOutrights(ctx context.Context, in *racing.ListOutrightsRequest) (*racing.ListOutrightsResponse, error)
+
+	// GetOutright will return an outright market by id.
+	GetOutright(ctx context.Context, in *racing.GetOutrightRequest) (*racing.GetOutrightResponse, error)
+
+	// SettleOutright will settle an outright market with its winning selection.
+	SettleOutright(ctx context.Context, in *racing.SettleOutrightRequest) (*racing.SettleOutrightResponse, error)
+
+	// WatchRaces will stream the live updates of races.
+	WatchRaces(in *racing.WatchRacesRequest, stream racing.Racing_WatchRacesServer) error
 }
 
 // racingService implements the Racing interface.
 type racingService struct {
-	racesRepo db.RacesRepo
+	racesRepo     db.RacesRepo
+	outrightsRepo db.OutrightsRepo
+	watcher       *RaceWatcher
 }
 
-// NewRacingService instantiates and returns a new racingService.
-func NewRacingService(racesRepo db.RacesRepo) Racing {
-	return &racingService{racesRepo}
+// NewRacingService instantiates and returns a new racingService. The live updates of races are streamed from the watcher.
+func NewRacingService(racesRepo db.RacesRepo, outrightsRepo db.OutrightsRepo, watcher *RaceWatcher) Racing {
+	return &racingService{racesRepo, outrightsRepo, watcher}
 }
 
 // Get a list of races with filter and order by clauses
 func (s *racingService) ListRaces(ctx context.Context, in *racing.ListRacesRequest) (*racing.ListRacesResponse, error) {
-	races, err := s.racesRepo.List(in.Filter, in.OrderBy)
+	if err := validateListRaces(in); err != nil {
+		return nil, err
+	}
+
+	races, err := s.racesRepo.List(ctx, in.Filter, in.OrderBy)
 	if err != nil {
 		return nil, err
 	}
@@ -36,10 +58,76 @@ func (s *racingService) ListRaces(ctx context.Context, in *racing.ListRacesReque
 
 // Get race details by id
 func (s *racingService) GetRaceById(ctx context.Context, in *racing.GetRaceRequest) (*racing.GetRaceResponse, error) {
-	race, err := s.racesRepo.GetRaceById(in.Id)
+	if in.Id <= 0 {
+		return nil, rpcerror.InvalidArgument("id", "id must be positive, got %d", in.Id)
+	}
+
+	race, err := s.racesRepo.GetRaceById(ctx, in.Id)
 	if err != nil {
 		return nil, err
 	}
 
+	if race == nil {
+		return nil, rpcerror.NotFound("racing.Race", in.Id, "race %d not found", in.Id)
+	}
+
 	return &racing.GetRaceResponse{Race: race}, nil
 }
+
+// validateListRaces returns an InvalidArgument error listing the invalid filters and order by fields of the request.
+func validateListRaces(in *racing.ListRacesRequest) error {
+	var v rpcerror.Violations
+
+	if filter := in.Filter; filter != nil {
+		for i, id := range filter.MeetingIds {
+			if id <= 0 {
+				v.Add(fmt.Sprintf("filter.meeting_ids[%d]", i), "meeting ids must be positive, got %d", id)
+			}
+		}
+
+		for i, id := range filter.VenueIds {
+			if id <= 0 {
+				v.Add(fmt.Sprintf("filter.venue_ids[%d]", i), "venue ids must be positive, got %d", id)
+			}
+		}
+
+		if filter.Country != "" && !isCountryCode(strings.TrimSpace(filter.Country)) {
+			v.Add("filter.country", "country must be an ISO 3166-1 alpha-2 code, e.g. AU, got %q", filter.Country)
+		}
+	}
+
+	if in.OrderBy != nil {
+		for i, field := range in.OrderBy.OrderByFields {
+			if !contains(db.RaceOrderByFields, field.Field) {
+				v.Add(fmt.Sprintf("order_by.order_by_fields[%d].field", i), "races can't be ordered by %q, use one of %s", field.Field, strings.Join(db.RaceOrderByFields, ", "))
+			}
+		}
+	}
+
+	return v.Err()
+}
+
+// isCountryCode reports whether the value looks like an ISO 3166-1 alpha-2 code, in any case.
+func isCountryCode(value string) bool {
+	if len(value) != 2 {
+		return false
+	}
+
+	for _, c := range strings.ToUpper(value) {
+		if c < 'A' || c > 'Z' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
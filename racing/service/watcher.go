@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"git.neds.sh/matty/entain/racing/db"
+	"git.neds.sh/matty/entain/racing/proto/racing"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RaceWatcher publishes the live updates of races to the WatchRaces streams. The status of races and outrights changes
+// with the time rather than with a write, so the changes are found by polling the database.
+type RaceWatcher struct {
+	racesRepo     db.RacesRepo
+	outrightsRepo db.OutrightsRepo
+	broker        *broker
+
+	mu               sync.Mutex
+	polled           bool
+	raceStatuses     map[int64]string
+	outrightStatuses map[int64]string
+}
+
+// NewRaceWatcher instantiates and returns a new RaceWatcher.
+func NewRaceWatcher(racesRepo db.RacesRepo, outrightsRepo db.OutrightsRepo) *RaceWatcher {
+	return &RaceWatcher{
+		racesRepo:        racesRepo,
+		outrightsRepo:    outrightsRepo,
+		broker:           newBroker(),
+		raceStatuses:     map[int64]string{},
+		outrightStatuses: map[int64]string{},
+	}
+}
+
+// Run polls the races and the outrights straight away and then at every interval until the context is done.
+// The first poll only records the current statuses, the changes are published from the second one on.
+func (w *RaceWatcher) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.poll(ctx, interval); err != nil {
+			log.Warnf("failed polling the races for their updates: %s", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *RaceWatcher) poll(ctx context.Context, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	races, err := w.racesRepo.List(ctx, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	outrights, err := w.outrightsRepo.List(ctx, &racing.ListOutrightsRequest{IncludeSettled: true})
+	if err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, race := range races {
+		if previous, ok := w.raceStatuses[race.Id]; ok && previous != race.Status || !ok && w.polled {
+			w.broker.publish(&racing.RaceUpdate{RaceId: race.Id, Update: &racing.RaceUpdate_Race{Race: race}})
+		}
+
+		w.raceStatuses[race.Id] = race.Status
+	}
+
+	for _, outright := range outrights {
+		w.updateOutright(outright)
+	}
+
+	w.polled = true
+
+	return nil
+}
+
+// publishOutright publishes an outright straight away, e.g. when it's settled, rather than on the next poll.
+func (w *RaceWatcher) publishOutright(outright *racing.Outright) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.updateOutright(outright)
+}
+
+// updateOutright must be called with the lock held.
+func (w *RaceWatcher) updateOutright(outright *racing.Outright) {
+	if previous, ok := w.outrightStatuses[outright.Id]; ok && previous != outright.Status || !ok && w.polled {
+		w.broker.publish(&racing.RaceUpdate{RaceId: outright.RaceId, Update: &racing.RaceUpdate_Outright{Outright: outright}})
+	}
+
+	w.outrightStatuses[outright.Id] = outright.Status
+}
+
+// Stream the live updates of races
+func (s *racingService) WatchRaces(in *racing.WatchRacesRequest, stream racing.Racing_WatchRacesServer) error {
+	raceIds := map[int64]bool{}
+	for _, id := range in.RaceIds {
+		raceIds[id] = true
+	}
+
+	updates, unsubscribe := s.watcher.broker.subscribe()
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case update, ok := <-updates:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "the stream fell too far behind, list the races to catch up")
+			}
+
+			if len(raceIds) > 0 && !raceIds[update.RaceId] {
+				continue
+			}
+
+			if err := stream.Send(update); err != nil {
+				return err
+			}
+		}
+	}
+}
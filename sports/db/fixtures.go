@@ -0,0 +1,288 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"git.neds.sh/matty/entain/sports/proto/sports"
+)
+
+// UpsertAction describes what an upsert did to a sport.
+type UpsertAction string
+
+const (
+	// FixtureCreated means a new sport was created for the fixture.
+	FixtureCreated UpsertAction = "created"
+	// FixtureUpdated means the sport previously imported for the fixture was updated.
+	FixtureUpdated UpsertAction = "updated"
+	// FixtureUnchanged means the sport previously imported for the fixture was already up to date.
+	FixtureUnchanged UpsertAction = "unchanged"
+)
+
+// FixturesRepo provides repository access for importing fixtures as sports.
+type FixturesRepo interface {
+	// Init will initialise our fixtures repository.
+	Init() error
+
+	// AddAlias registers an alternative name for a team. The team is created if it doesn't exist.
+	AddAlias(ctx context.Context, alias string, team string) error
+
+	// ResolveTeam returns the canonical name of the team matching the given name or alias. The team is created if it doesn't exist.
+	ResolveTeam(ctx context.Context, name string) (string, error)
+
+	// SetCompetitionSport sets the kind of sport (soccer, afl etc.) played in a competition. The competition is created if it doesn't exist.
+	SetCompetitionSport(ctx context.Context, competition string, sport string) error
+
+	// SetCompetitionPoints sets the ladder points awarded for a win, a draw and a loss in a competition. The competition is created if it doesn't exist.
+	SetCompetitionPoints(ctx context.Context, competition string, rules *sports.PointsRules) error
+
+	// Upsert creates or updates the sport imported from the fixture with the given source uid. The sport id is set on the given sport.
+	Upsert(ctx context.Context, sourceUid string, sport *sports.Sport) (UpsertAction, error)
+}
+
+type fixturesRepo struct {
+	db   *sql.DB
+	init sync.Once
+}
+
+// NewFixturesRepo creates a new fixtures repository.
+func NewFixturesRepo(db *sql.DB) FixturesRepo {
+	return &fixturesRepo{db: db}
+}
+
+// Init creates the teams tables if they don't exist.
+func (r *fixturesRepo) Init() error {
+	var err error
+
+	r.init.Do(func() {
+		for _, statement := range []string{
+			`CREATE TABLE IF NOT EXISTS teams (id INTEGER PRIMARY KEY, name TEXT UNIQUE COLLATE NOCASE)`,
+			`CREATE TABLE IF NOT EXISTS team_aliases (alias TEXT PRIMARY KEY COLLATE NOCASE, team_id INTEGER)`,
+		} {
+			if _, err = r.db.Exec(statement); err != nil {
+				return
+			}
+		}
+	})
+
+	return err
+}
+
+// Register an alias for a team
+func (r *fixturesRepo) AddAlias(ctx context.Context, alias string, team string) (err error) {
+	ctx, span := startQuerySpan(ctx, "fixtures.AddAlias", "")
+	defer func() { endQuerySpan(span, err) }()
+
+	teamId, err := upsertNamed(ctx, r.db, "teams", team)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecContext(ctx, `INSERT OR REPLACE INTO team_aliases (alias, team_id) VALUES (?, ?)`, normaliseName(alias), teamId)
+
+	return err
+}
+
+// Find the canonical team name by matching the team names first and then the aliases
+func (r *fixturesRepo) ResolveTeam(ctx context.Context, name string) (_ string, err error) {
+	var canonical string
+
+	ctx, span := startQuerySpan(ctx, "fixtures.ResolveTeam", "")
+	defer func() { endQuerySpan(span, err) }()
+
+	name = normaliseName(name)
+
+	err = r.db.QueryRowContext(ctx, `
+		SELECT name FROM teams WHERE name = ?
+		UNION ALL
+		SELECT teams.name FROM team_aliases JOIN teams ON teams.id = team_aliases.team_id WHERE team_aliases.alias = ?
+		LIMIT 1
+	`, name, name).Scan(&canonical)
+
+	if err == sql.ErrNoRows {
+		if _, err := upsertNamed(ctx, r.db, "teams", name); err != nil {
+			return "", err
+		}
+
+		return name, nil
+	}
+
+	return canonical, err
+}
+
+// Set the kind of sport played in a competition
+func (r *fixturesRepo) SetCompetitionSport(ctx context.Context, competition string, sport string) (err error) {
+	ctx, span := startQuerySpan(ctx, "fixtures.SetCompetitionSport", "")
+	defer func() { endQuerySpan(span, err) }()
+
+	competitionId, err := upsertNamed(ctx, r.db, "competitions", competition)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecContext(ctx, `UPDATE competitions SET sport = ? WHERE id = ?`, strings.ToLower(sport), competitionId)
+
+	return err
+}
+
+// Set the points rules of a competition. Its standings are rebuilt when the rules change.
+func (r *fixturesRepo) SetCompetitionPoints(ctx context.Context, competition string, rules *sports.PointsRules) (err error) {
+	ctx, span := startQuerySpan(ctx, "fixtures.SetCompetitionPoints", "")
+	defer func() { endQuerySpan(span, err) }()
+
+	competitionId, err := upsertNamed(ctx, r.db, "competitions", competition)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, getFixtureQueries()[fixturePoints], rules.Win, rules.Draw, rules.Loss, competitionId)
+	if err != nil {
+		return err
+	}
+
+	changed, err := res.RowsAffected()
+	if err != nil || changed == 0 {
+		return err
+	}
+
+	if err := rebuildStandings(ctx, tx, competitionId); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Create or update the sport imported from the fixture with the given source uid.
+//
+// The competition and the venue are looked up by name and created if they don't exist.
+// A sport that's already up to date is left alone, so importing the same file twice doesn't change anything.
+func (r *fixturesRepo) Upsert(ctx context.Context, sourceUid string, sport *sports.Sport) (_ UpsertAction, err error) {
+	ctx, span := startQuerySpan(ctx, "fixtures.Upsert", "")
+	defer func() { endQuerySpan(span, err) }()
+
+	advertisedStart, err := ptypes.Timestamp(sport.AdvertisedStartTime)
+	if err != nil {
+		return "", err
+	}
+
+	bettingClosed, err := ptypes.Timestamp(sport.BettingClosedTime)
+	if err != nil {
+		return "", err
+	}
+
+	if sport.Competition != "" {
+		if sport.CompetitionId, err = upsertNamed(ctx, r.db, "competitions", sport.Competition); err != nil {
+			return "", err
+		}
+	}
+
+	var venueId int64
+	if sport.Venue != nil && sport.Venue.Name != "" {
+		if venueId, err = upsertVenue(ctx, r.db, sport.Venue.Name); err != nil {
+			return "", err
+		}
+	}
+
+	var (
+		existing                             sports.Sport
+		existingStart, existingBettingClosed time.Time
+		existingVenueId                      int64
+	)
+
+	err = r.db.QueryRowContext(ctx, getFixtureQueries()[fixtureBySourceUid], sourceUid).Scan(&existing.Id, &existing.Name, &existing.HomeTeam, &existing.AwayTeam, &existingStart, &existingBettingClosed, &existing.CompetitionId, &existingVenueId, &existing.Season)
+
+	switch {
+	case err == sql.ErrNoRows:
+		res, err := r.db.ExecContext(ctx, getFixtureQueries()[fixtureInsert], sport.Name, sport.HomeTeam, sport.AwayTeam, advertisedStart.UTC().Format(time.RFC3339), bettingClosed.UTC().Format(time.RFC3339), sport.CompetitionId, venueId, sport.Season, sourceUid)
+		if err != nil {
+			return "", err
+		}
+
+		if sport.Id, err = res.LastInsertId(); err != nil {
+			return "", err
+		}
+
+		return FixtureCreated, nil
+	case err != nil:
+		return "", err
+	}
+
+	sport.Id = existing.Id
+
+	if existing.Name == sport.Name &&
+		existing.HomeTeam == sport.HomeTeam &&
+		existing.AwayTeam == sport.AwayTeam &&
+		existingStart.Equal(advertisedStart) &&
+		existingBettingClosed.Equal(bettingClosed) &&
+		existing.CompetitionId == sport.CompetitionId &&
+		existingVenueId == venueId &&
+		existing.Season == sport.Season {
+		return FixtureUnchanged, nil
+	}
+
+	if err := r.update(ctx, sport, advertisedStart, bettingClosed, venueId); err != nil {
+		return "", err
+	}
+
+	return FixtureUpdated, nil
+}
+
+// update saves the changes to a sport. A sport that already has a final result is moved in the standings as well,
+// in case its competition, season or teams have changed.
+func (r *fixturesRepo) update(ctx context.Context, sport *sports.Sport, advertisedStart, bettingClosed time.Time, venueId int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := scanResult(tx.QueryRowContext(ctx, getResultQueries()[resultByEventId], sport.Id))
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+
+	if err := applyResultToStandings(ctx, tx, result, -1); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, getFixtureQueries()[fixtureUpdate], sport.Name, sport.HomeTeam, sport.AwayTeam, advertisedStart.UTC().Format(time.RFC3339), bettingClosed.UTC().Format(time.RFC3339), sport.CompetitionId, venueId, sport.Season, sport.Id); err != nil {
+		return err
+	}
+
+	if err := applyResultToStandings(ctx, tx, result, 1); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// upsertNamed returns the id of the row with the given name in a table with (id, name) columns, creating the row if it doesn't exist.
+func upsertNamed(ctx context.Context, db *sql.DB, table string, name string) (int64, error) {
+	var id int64
+
+	name = normaliseName(name)
+
+	if _, err := db.ExecContext(ctx, `INSERT OR IGNORE INTO `+table+` (name) VALUES (?)`, name); err != nil {
+		return 0, err
+	}
+
+	err := db.QueryRowContext(ctx, `SELECT id FROM `+table+` WHERE name = ?`, name).Scan(&id)
+
+	return id, err
+}
+
+// normaliseName trims the name and collapses repeated white space so that names can be matched reliably.
+func normaliseName(name string) string {
+	return strings.Join(strings.Fields(name), " ")
+}
@@ -0,0 +1,103 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestResolveTeam(t *testing.T) {
+	ctx := context.Background()
+	repo := NewFixturesRepo(newTestDB(t))
+
+	if err := repo.AddAlias(ctx, "Pies", "Collingwood"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := repo.AddAlias(ctx, "The  Cats", "Geelong Cats"); err != nil {
+		t.Fatal(err)
+	}
+
+	// The steps run in order, as resolving an unknown team creates it.
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "Collingwood", want: "Collingwood"},
+		{name: "COLLINGWOOD", want: "Collingwood"},
+		{name: "pies", want: "Collingwood"},
+		{name: "  Pies ", want: "Collingwood"},
+		{name: "the cats", want: "Geelong Cats"},
+		{name: "Geelong cats", want: "Geelong Cats"},
+		{name: "  Port   Adelaide ", want: "Port Adelaide"},
+		{name: "port adelaide", want: "Port Adelaide"},
+	}
+
+	for _, tt := range tests {
+		got, err := repo.ResolveTeam(ctx, tt.name)
+		if err != nil {
+			t.Fatalf("ResolveTeam(%q) error = %v", tt.name, err)
+		}
+
+		if got != tt.want {
+			t.Errorf("ResolveTeam(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestAddAliasReplacesTheTeam(t *testing.T) {
+	ctx := context.Background()
+	repo := NewFixturesRepo(newTestDB(t))
+
+	for _, team := range []string{"Sydney Swans", "Sydney FC"} {
+		if err := repo.AddAlias(ctx, "Sydney", team); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if got, err := repo.ResolveTeam(ctx, "Sydney"); err != nil || got != "Sydney FC" {
+		t.Errorf("ResolveTeam(Sydney) = %q, %v, want Sydney FC", got, err)
+	}
+}
+
+func TestNormaliseName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "Carlton", want: "Carlton"},
+		{name: "  Carlton  ", want: "Carlton"},
+		{name: "West  Coast\tEagles", want: "West Coast Eagles"},
+		{name: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := normaliseName(tt.name); got != tt.want {
+			t.Errorf("normaliseName(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+// newTestDB returns an in-memory database with the tables of the repositories.
+func newTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Every connection to :memory: opens its own database.
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { db.Close() })
+
+	for _, repo := range []interface{ Init() error }{NewSportsRepo(db), NewResultsRepo(db), NewFixturesRepo(db), NewStandingsRepo(db)} {
+		if err := repo.Init(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return db
+}
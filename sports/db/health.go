@@ -0,0 +1,14 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+// Ping checks that the database can be queried. A plain sql.DB Ping doesn't catch much with SQLite,
+// as the database file is opened lazily and stays open, so this reads the schema instead.
+func Ping(ctx context.Context, db *sql.DB) error {
+	var tables int
+
+	return db.QueryRowContext(ctx, `SELECT COUNT(*) FROM sqlite_master WHERE type = 'table'`).Scan(&tables)
+}
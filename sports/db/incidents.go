@@ -0,0 +1,194 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"git.neds.sh/matty/entain/sports/proto/sports"
+)
+
+// IncidentsRepo provides repository access to the incident timelines of sports events.
+type IncidentsRepo interface {
+	// Init will initialise our incidents repository.
+	Init() error
+
+	// Add appends the given incident to the timeline of its sports event. The sequence is assigned here.
+	Add(ctx context.Context, incident *sports.Incident) (*sports.Incident, error)
+
+	// List returns the incidents of a sports event with a sequence greater than the given one.
+	List(ctx context.Context, eventId int64, sinceSequence int64) ([]*sports.Incident, error)
+
+	// ListTypes returns the incident types that are valid for a sports event.
+	ListTypes(ctx context.Context, eventId int64) ([]string, error)
+}
+
+type incidentsRepo struct {
+	db   *sql.DB
+	init sync.Once
+}
+
+// defaultIncidentTypes are the incident types available out of the box. Types with an empty sport apply to all sports.
+// More types can be added to the incident_types table without any code changes.
+var defaultIncidentTypes = []struct {
+	sport       string
+	incident    string
+	description string
+}{
+	{"", "PERIOD_START", "Start of a period"},
+	{"", "PERIOD_END", "End of a period"},
+	{"", "GOAL", "Goal scored"},
+	{"", "SUBSTITUTION", "Player substituted"},
+	{"", "INJURY", "Player injured"},
+	{"soccer", "OWN_GOAL", "Own goal"},
+	{"soccer", "PENALTY", "Penalty awarded"},
+	{"soccer", "PENALTY_MISSED", "Penalty missed"},
+	{"soccer", "YELLOW_CARD", "Yellow card"},
+	{"soccer", "RED_CARD", "Red card"},
+	{"soccer", "VAR_REVIEW", "Video assistant referee review"},
+	{"afl", "BEHIND", "Behind scored"},
+	{"afl", "RUSHED_BEHIND", "Rushed behind"},
+	{"afl", "FIFTY_METRE_PENALTY", "50 metre penalty"},
+	{"rugby", "TRY", "Try scored"},
+	{"rugby", "CONVERSION", "Conversion kicked"},
+	{"rugby", "PENALTY_GOAL", "Penalty goal kicked"},
+	{"rugby", "SIN_BIN", "Player sent to the sin bin"},
+}
+
+// NewIncidentsRepo creates a new incidents repository.
+func NewIncidentsRepo(db *sql.DB) IncidentsRepo {
+	return &incidentsRepo{db: db}
+}
+
+// Init creates the incidents tables if they don't exist and adds the default incident types.
+func (r *incidentsRepo) Init() error {
+	var err error
+
+	r.init.Do(func() {
+		err = r.createTables()
+	})
+
+	return err
+}
+
+func (r *incidentsRepo) createTables() error {
+	statements := []string{
+		`CREATE TABLE IF NOT EXISTS incidents (id INTEGER PRIMARY KEY, event_id INTEGER, sequence INTEGER, type TEXT, period INTEGER, minute INTEGER, side INTEGER, team TEXT, player TEXT, description TEXT, created_at DATETIME, UNIQUE (event_id, sequence))`,
+		`CREATE TABLE IF NOT EXISTS incident_types (sport TEXT, type TEXT, description TEXT, PRIMARY KEY (sport, type))`,
+	}
+
+	for _, statement := range statements {
+		if _, err := r.db.Exec(statement); err != nil {
+			return err
+		}
+	}
+
+	for _, incidentType := range defaultIncidentTypes {
+		if _, err := r.db.Exec(`INSERT OR IGNORE INTO incident_types (sport, type, description) VALUES (?,?,?)`, incidentType.sport, incidentType.incident, incidentType.description); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Append an incident to the timeline of a sports event
+func (r *incidentsRepo) Add(ctx context.Context, incident *sports.Incident) (_ *sports.Incident, err error) {
+	ctx, span := startQuerySpan(ctx, "incidents.Add", "")
+	defer func() { endQuerySpan(span, err) }()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	createdAt := time.Now().UTC().Truncate(time.Second)
+
+	if incident.CreatedAt, err = ptypes.TimestampProto(createdAt); err != nil {
+		return nil, err
+	}
+
+	res, err := tx.ExecContext(ctx, getIncidentQueries()[incidentInsert], incident.EventId, incident.Type, incident.Period, incident.Minute, int32(incident.Side), incident.Team, incident.Player, incident.Description, createdAt.Format(time.RFC3339), incident.EventId)
+	if err != nil {
+		return nil, err
+	}
+
+	if incident.Id, err = res.LastInsertId(); err != nil {
+		return nil, err
+	}
+
+	if err := tx.QueryRowContext(ctx, `SELECT sequence FROM incidents WHERE id = ?`, incident.Id).Scan(&incident.Sequence); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return incident, nil
+}
+
+// Get the incidents of a sports event added after the given sequence
+func (r *incidentsRepo) List(ctx context.Context, eventId int64, sinceSequence int64) (incidents []*sports.Incident, err error) {
+	query := getIncidentQueries()[incidentsList]
+
+	ctx, span := startQuerySpan(ctx, "incidents.List", query)
+	defer func() { endQuerySpan(span, err) }()
+
+	rows, err := r.db.QueryContext(ctx, query, eventId, sinceSequence)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			incident  sports.Incident
+			side      int32
+			createdAt time.Time
+		)
+
+		if err := rows.Scan(&incident.Id, &incident.EventId, &incident.Sequence, &incident.Type, &incident.Period, &incident.Minute, &side, &incident.Team, &incident.Player, &incident.Description, &createdAt); err != nil {
+			return nil, err
+		}
+
+		incident.Side = sports.Incident_Side(side)
+
+		if incident.CreatedAt, err = ptypes.TimestampProto(createdAt); err != nil {
+			return nil, err
+		}
+
+		incidents = append(incidents, &incident)
+	}
+
+	return incidents, rows.Err()
+}
+
+// Get the incident types that are valid for a sports event
+func (r *incidentsRepo) ListTypes(ctx context.Context, eventId int64) (types []string, err error) {
+	query := getIncidentQueries()[incidentTypes]
+
+	ctx, span := startQuerySpan(ctx, "incidents.ListTypes", query)
+	defer func() { endQuerySpan(span, err) }()
+
+	rows, err := r.db.QueryContext(ctx, query, eventId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var incidentType string
+		if err := rows.Scan(&incidentType); err != nil {
+			return nil, err
+		}
+
+		types = append(types, incidentType)
+	}
+
+	return types, rows.Err()
+}
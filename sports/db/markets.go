@@ -0,0 +1,204 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"git.neds.sh/matty/entain/sports/proto/sports"
+)
+
+// MarketsRepo provides repository access to the betting markets of sports events and their suspensions.
+//
+// A whole sports event can be suspended on top of its markets. A market is open only when neither of them is suspended,
+// so resuming an event leaves the markets that were suspended on their own suspended.
+type MarketsRepo interface {
+	// Init will initialise our markets repository.
+	Init() error
+
+	// List returns the markets of a sports event.
+	List(ctx context.Context, eventId int64) ([]*sports.Market, error)
+
+	// Get returns a market of a sports event. It'll be nil if the market doesn't exist.
+	Get(ctx context.Context, eventId int64, marketId int64) (*sports.Market, error)
+
+	// Suspend suspends a market, or the whole sports event when the market id is 0.
+	// The returned change is nil if it was already suspended, in which case the original suspension is kept.
+	Suspend(ctx context.Context, eventId int64, marketId int64, reason string, actor string) (*sports.SuspensionChange, error)
+
+	// Resume reopens a market, or the whole sports event when the market id is 0.
+	// The returned change is nil if it wasn't suspended.
+	Resume(ctx context.Context, eventId int64, marketId int64, actor string) (*sports.SuspensionChange, error)
+}
+
+type marketsRepo struct {
+	db   *sql.DB
+	init sync.Once
+}
+
+// NewMarketsRepo creates a new markets repository.
+func NewMarketsRepo(db *sql.DB) MarketsRepo {
+	return &marketsRepo{db: db}
+}
+
+// Init creates the markets tables if they don't exist. For test/example purposes, the sports without markets get some dummy ones.
+func (r *marketsRepo) Init() error {
+	var err error
+
+	r.init.Do(func() {
+		err = r.createTables()
+	})
+
+	return err
+}
+
+func (r *marketsRepo) createTables() error {
+	statements := []string{
+		`CREATE TABLE IF NOT EXISTS markets (id INTEGER PRIMARY KEY, event_id INTEGER, name TEXT, suspension_reason TEXT, suspended_by TEXT, suspended_at DATETIME)`,
+		// Every suspension and resumption is kept so that it's known who suspended what and why.
+		`CREATE TABLE IF NOT EXISTS suspension_history (id INTEGER PRIMARY KEY, event_id INTEGER, market_id INTEGER, suspended INTEGER, reason TEXT, actor TEXT, created_at DATETIME)`,
+		getMarketQueries()[marketsSeed],
+	}
+
+	for _, statement := range statements {
+		if _, err := r.db.Exec(statement); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Get the markets of a sports event
+func (r *marketsRepo) List(ctx context.Context, eventId int64) (markets []*sports.Market, err error) {
+	query := getMarketQueries()[marketsList]
+
+	ctx, span := startQuerySpan(ctx, "markets.List", query)
+	defer func() { endQuerySpan(span, err) }()
+
+	rows, err := r.db.QueryContext(ctx, query, eventId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		market, err := scanMarket(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		markets = append(markets, market)
+	}
+
+	return markets, rows.Err()
+}
+
+// Get a market of a sports event
+func (r *marketsRepo) Get(ctx context.Context, eventId int64, marketId int64) (*sports.Market, error) {
+	query := getMarketQueries()[marketById]
+
+	ctx, span := startQuerySpan(ctx, "markets.Get", query)
+
+	market, err := scanMarket(r.db.QueryRowContext(ctx, query, eventId, marketId))
+	if err == sql.ErrNoRows {
+		market, err = nil, nil
+	}
+
+	endQuerySpan(span, err)
+
+	return market, err
+}
+
+// Suspend a market or a whole sports event
+func (r *marketsRepo) Suspend(ctx context.Context, eventId int64, marketId int64, reason string, actor string) (*sports.SuspensionChange, error) {
+	now := time.Now().UTC().Truncate(time.Second)
+
+	return r.change(ctx, "markets.Suspend", eventId, marketId, &sports.SuspensionChange{MarketId: marketId, Suspended: true, Reason: reason, Actor: actor}, now,
+		`SET suspension_reason = ?, suspended_by = ?, suspended_at = ? WHERE suspended_at IS NULL`, reason, actor, now.Format(time.RFC3339))
+}
+
+// Resume a market or a whole sports event
+func (r *marketsRepo) Resume(ctx context.Context, eventId int64, marketId int64, actor string) (*sports.SuspensionChange, error) {
+	now := time.Now().UTC().Truncate(time.Second)
+
+	return r.change(ctx, "markets.Resume", eventId, marketId, &sports.SuspensionChange{MarketId: marketId, Actor: actor}, now,
+		`SET suspension_reason = NULL, suspended_by = NULL, suspended_at = NULL WHERE suspended_at IS NOT NULL`)
+}
+
+// change applies the given SET ... WHERE ... clause to the market or sport and records the change in the suspension history.
+// The WHERE clause makes sure that only one of two concurrent changes takes effect. The span of the change has the given name.
+func (r *marketsRepo) change(ctx context.Context, name string, eventId int64, marketId int64, change *sports.SuspensionChange, now time.Time, clause string, args ...interface{}) (_ *sports.SuspensionChange, err error) {
+	ctx, span := startQuerySpan(ctx, name, "")
+	defer func() { endQuerySpan(span, err) }()
+
+	if change.ChangedAt, err = ptypes.TimestampProto(now); err != nil {
+		return nil, err
+	}
+
+	query := `UPDATE sports ` + clause + ` AND id = ?`
+	args = append(args, eventId)
+
+	if marketId != 0 {
+		query = `UPDATE markets ` + clause + ` AND event_id = ? AND id = ?`
+		args = append(args, marketId)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	if affected, err := res.RowsAffected(); err != nil || affected == 0 {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, getMarketQueries()[suspensionHistory], eventId, marketId, change.Suspended, change.Reason, change.Actor, now.Format(time.RFC3339)); err != nil {
+		return nil, err
+	}
+
+	return change, tx.Commit()
+}
+
+func scanMarket(row scanner) (*sports.Market, error) {
+	var (
+		market         sports.Market
+		suspension     sports.Suspension
+		suspendedAt    sql.NullTime
+		eventSuspended bool
+	)
+
+	if err := row.Scan(&market.Id, &market.EventId, &market.Name, &suspension.Reason, &suspension.Actor, &suspendedAt, &eventSuspended); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if market.Suspension, err = suspensionOf(&suspension, suspendedAt); err != nil {
+		return nil, err
+	}
+
+	market.Suspended = eventSuspended || market.Suspension != nil
+
+	return &market, nil
+}
+
+// suspensionOf returns the given suspension with its time set, or nil if nothing is suspended.
+func suspensionOf(suspension *sports.Suspension, suspendedAt sql.NullTime) (*sports.Suspension, error) {
+	if !suspendedAt.Valid {
+		return nil, nil
+	}
+
+	var err error
+	suspension.SuspendedAt, err = ptypes.TimestampProto(suspendedAt.Time)
+
+	return suspension, err
+}
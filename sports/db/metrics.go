@@ -0,0 +1,30 @@
+package db
+
+import (
+	"context"
+
+	"git.neds.sh/matty/entain/common/metrics"
+	"git.neds.sh/matty/entain/sports/proto/sports"
+)
+
+// instrumentedSportsRepo records the duration of the queries of a SportsRepo.
+type instrumentedSportsRepo struct {
+	SportsRepo
+}
+
+// NewInstrumentedSportsRepo wraps a sports repository to record the duration of its queries.
+func NewInstrumentedSportsRepo(repo SportsRepo) SportsRepo {
+	return &instrumentedSportsRepo{SportsRepo: repo}
+}
+
+func (r *instrumentedSportsRepo) List(ctx context.Context, filter *sports.ListEventsRequestFilter, orderBy *sports.ListEventsRequestOrderBy) ([]*sports.Sport, error) {
+	defer metrics.ObserveQuery("sports", "List")()
+
+	return r.SportsRepo.List(ctx, filter, orderBy)
+}
+
+func (r *instrumentedSportsRepo) GetSportById(ctx context.Context, sportId int64) (*sports.Sport, error) {
+	defer metrics.ObserveQuery("sports", "GetSportById")()
+
+	return r.SportsRepo.GetSportById(ctx, sportId)
+}
@@ -0,0 +1,85 @@
+package db
+
+import (
+	"database/sql"
+)
+
+// migrate brings an existing sports table up to date with the tables and columns added after it was first created.
+func (r *sportsRepo) migrate() error {
+	statements := []string{
+		`CREATE TABLE IF NOT EXISTS competitions (id INTEGER PRIMARY KEY, name TEXT UNIQUE COLLATE NOCASE)`,
+		`CREATE TABLE IF NOT EXISTS venues (id INTEGER PRIMARY KEY, name TEXT UNIQUE COLLATE NOCASE, city TEXT, country TEXT, latitude REAL, longitude REAL, timezone TEXT)`,
+	}
+
+	for _, statement := range statements {
+		if _, err := r.db.Exec(statement); err != nil {
+			return err
+		}
+	}
+
+	columns := []struct {
+		table      string
+		name       string
+		definition string
+	}{
+		{"sports", "competition_id", "INTEGER"},
+		{"sports", "venue_id", "INTEGER"},
+		// source_uid identifies the fixture a sport was imported from. It's used to make fixture imports idempotent.
+		{"sports", "source_uid", "TEXT"},
+		{"sports", "season", "TEXT"},
+		// The current suspension of the sport's markets. A sport is suspended while suspended_at is set.
+		{"sports", "suspension_reason", "TEXT"},
+		{"sports", "suspended_by", "TEXT"},
+		{"sports", "suspended_at", "DATETIME"},
+		// sport is the kind of sport (soccer, afl etc.) played in the competition.
+		{"competitions", "sport", "TEXT NOT NULL DEFAULT ''"},
+		// The points rules of the competition. The defaults of the sport are used when they're not set.
+		{"competitions", "points_win", "INTEGER"},
+		{"competitions", "points_draw", "INTEGER"},
+		{"competitions", "points_loss", "INTEGER"},
+		// The location of the venue. The timezone is an IANA time zone name used to show the local start times.
+		{"venues", "city", "TEXT"},
+		{"venues", "country", "TEXT"},
+		{"venues", "latitude", "REAL"},
+		{"venues", "longitude", "REAL"},
+		{"venues", "timezone", "TEXT"},
+	}
+
+	for _, column := range columns {
+		if err := addColumn(r.db, column.table, column.name, column.definition); err != nil {
+			return err
+		}
+	}
+
+	_, err := r.db.Exec(`CREATE UNIQUE INDEX IF NOT EXISTS sports_source_uid ON sports (source_uid)`)
+
+	return err
+}
+
+// addColumn adds a column to the given table unless the table already has it. SQLite has no ADD COLUMN IF NOT EXISTS.
+func addColumn(db *sql.DB, table, column, definition string) error {
+	rows, err := db.Query(`SELECT name FROM pragma_table_info(?)`, table)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return err
+		}
+
+		if name == column {
+			return nil
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	_, err = db.Exec(`ALTER TABLE ` + table + ` ADD COLUMN ` + column + ` ` + definition)
+
+	return err
+}
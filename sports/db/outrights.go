@@ -0,0 +1,211 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"git.neds.sh/matty/entain/common/outrights"
+	"git.neds.sh/matty/entain/sports/proto/sports"
+)
+
+// OutrightsRepo provides repository access to the outright (futures) markets of competitions.
+type OutrightsRepo interface {
+	// Init will initialise our outrights repository.
+	Init() error
+
+	// List returns the outrights matching the request, the ones closing first first.
+	List(ctx context.Context, in *sports.ListOutrightsRequest) ([]*sports.Outright, error)
+
+	// Get returns an outright by id. It'll be nil if the outright doesn't exist.
+	Get(ctx context.Context, id int64) (*sports.Outright, error)
+
+	// Settle records the winning selection of an outright. It returns false if the outright was already settled
+	// or the selection doesn't belong to it.
+	Settle(ctx context.Context, id int64, winningSelectionId int64, actor string) (bool, error)
+}
+
+type outrightsRepo struct {
+	db   *sql.DB
+	init sync.Once
+}
+
+// defaultOutrights are seeded for test/example purposes when there are no outrights.
+var defaultOutrights = []struct {
+	competition string
+	name        string
+	closeIn     time.Duration
+	selections  []outrights.Selection
+}{
+	{"AFL Premiership", "Premiership winner", 30 * 24 * time.Hour, []outrights.Selection{
+		{Name: "Melbourne", Price: 4.5},
+		{Name: "Western Bulldogs", Price: 5},
+		{Name: "Geelong Cats", Price: 6.5},
+		{Name: "Brisbane Lions", Price: 7},
+		{Name: "Port Adelaide", Price: 8},
+		{Name: "Sydney Swans", Price: 11},
+	}},
+	{"English Premier League", "League winner", -24 * time.Hour, []outrights.Selection{
+		{Name: "Manchester City", Price: 2.2},
+		{Name: "Liverpool", Price: 3.5},
+		{Name: "Chelsea", Price: 4},
+		{Name: "Manchester United", Price: 9},
+		{Name: "Arsenal", Price: 21},
+	}},
+}
+
+// NewOutrightsRepo creates a new outrights repository.
+func NewOutrightsRepo(db *sql.DB) OutrightsRepo {
+	return &outrightsRepo{db: db}
+}
+
+// Init creates the outrights tables if they don't exist and seeds some dummy outrights.
+func (r *outrightsRepo) Init() error {
+	var err error
+
+	r.init.Do(func() {
+		err = outrights.CreateTables(r.db, "competition_id INTEGER, season TEXT")
+		if err == nil {
+			err = r.seed()
+		}
+	})
+
+	return err
+}
+
+func (r *outrightsRepo) seed() error {
+	var count int64
+	if err := r.db.QueryRow(`SELECT COUNT(*) FROM outrights`).Scan(&count); err != nil || count > 0 {
+		return err
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	for _, outright := range defaultOutrights {
+		competitionId, err := upsertNamed(context.Background(), r.db, "competitions", outright.competition)
+		if err != nil {
+			return err
+		}
+
+		res, err := r.db.Exec(getOutrightQueries()[outrightInsert], competitionId, now.Format("2006"), outright.name, now.Add(outright.closeIn).Format(time.RFC3339))
+		if err != nil {
+			return err
+		}
+
+		outrightId, err := res.LastInsertId()
+		if err != nil {
+			return err
+		}
+
+		if err := outrights.AddSelections(r.db, outrightId, outright.selections); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Get the outrights of a competition season
+func (r *outrightsRepo) List(ctx context.Context, in *sports.ListOutrightsRequest) ([]*sports.Outright, error) {
+	var (
+		clauses []string
+		args    []interface{}
+	)
+
+	if in.CompetitionId != 0 {
+		clauses = append(clauses, "outrights.competition_id = ?")
+		args = append(args, in.CompetitionId)
+	}
+
+	if in.Season != "" {
+		clauses = append(clauses, "outrights.season = ?")
+		args = append(args, in.Season)
+	}
+
+	if !in.IncludeSettled {
+		clauses = append(clauses, "outrights.settled_at IS NULL")
+	}
+
+	query := getOutrightQueries()[outrightsList]
+	if len(clauses) != 0 {
+		query += " WHERE " + strings.Join(clauses, " AND ")
+	}
+
+	return r.query(ctx, "outrights.List", query+" ORDER BY outrights.close_time, outrights.id", args...)
+}
+
+// Get an outright by id
+func (r *outrightsRepo) Get(ctx context.Context, id int64) (*sports.Outright, error) {
+	outrights, err := r.query(ctx, "outrights.Get", getOutrightQueries()[outrightsList]+" WHERE outrights.id = ?", id)
+	if err != nil || len(outrights) == 0 {
+		return nil, err
+	}
+
+	return outrights[0], nil
+}
+
+// Settle an outright with its winning selection
+func (r *outrightsRepo) Settle(ctx context.Context, id int64, winningSelectionId int64, actor string) (_ bool, err error) {
+	ctx, span := startQuerySpan(ctx, "outrights.Settle", outrights.SettleQuery)
+	defer func() { endQuerySpan(span, err) }()
+
+	return outrights.Settle(ctx, r.db, id, winningSelectionId, actor)
+}
+
+// query runs an outrights query, in a span with the given name.
+func (r *outrightsRepo) query(ctx context.Context, name string, query string, args ...interface{}) (_ []*sports.Outright, err error) {
+	ctx, span := startQuerySpan(ctx, name, "")
+	defer func() { endQuerySpan(span, err) }()
+
+	var found []*sports.Outright
+
+	rows, err := outrights.Query(ctx, r.db, query, args, func() []interface{} {
+		outright := &sports.Outright{}
+		found = append(found, outright)
+
+		return []interface{}{&outright.CompetitionId, &outright.Competition, &outright.Season}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, row := range rows {
+		if err := fromRow(found[i], row); err != nil {
+			return nil, err
+		}
+	}
+
+	return found, nil
+}
+
+// fromRow sets the shared part of an outright. The results of the selections are derived from the settlement.
+func fromRow(outright *sports.Outright, row *outrights.Outright) (err error) {
+	outright.Id, outright.Name, outright.Status = row.Id, row.Name, row.Status()
+
+	if outright.CloseTime, err = ptypes.TimestampProto(row.CloseTime); err != nil {
+		return err
+	}
+
+	if row.SettledAt.Valid {
+		outright.Settlement = &sports.OutrightSettlement{WinningSelectionId: row.WinningSelectionId, Actor: row.SettledBy}
+
+		if outright.Settlement.SettledAt, err = ptypes.TimestampProto(row.SettledAt.Time); err != nil {
+			return err
+		}
+	}
+
+	for _, selection := range row.Selections {
+		outright.Selections = append(outright.Selections, &sports.OutrightSelection{
+			Id:     selection.Id,
+			Name:   selection.Name,
+			Price:  selection.Price,
+			Result: row.Result(selection),
+		})
+	}
+
+	return nil
+}
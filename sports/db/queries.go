@@ -1,10 +1,16 @@
 package db
 
+import "git.neds.sh/matty/entain/common/outrights"
+
 const (
 	sportsList = "list"
 	sportById  = "tuple"
 )
 
+// seasonExpression is the season of a sport. Sports without a season belong to the season of the year they start in, in
+// UTC as the start times are stored in UTC. It's the only place the season is derived, the imported fixtures included.
+const seasonExpression = `COALESCE(NULLIF(sports.season, ''), strftime('%Y', sports.advertised_start_time))`
+
 func getSportQueries() map[string]string {
 	return map[string]string{
 		sportsList: `
@@ -17,7 +23,15 @@ func getSportQueries() map[string]string {
 				home_team,
 				away_team, 
 				advertised_start_time,
-				betting_closed_time
+				betting_closed_time,
+				EXISTS (SELECT 1 FROM results WHERE results.event_id = sports.id AND results.final = 1) AS finished,
+				COALESCE(competition_id, 0) AS competition_id,
+				COALESCE((SELECT name FROM competitions WHERE competitions.id = sports.competition_id), '') AS competition,
+				COALESCE(venue_id, 0) AS venue_id,
+				` + seasonExpression + ` AS season,
+				COALESCE(suspension_reason, '') AS suspension_reason,
+				COALESCE(suspended_by, '') AS suspended_by,
+				suspended_at
 			FROM sports
 		`,
 		sportById: `
@@ -30,9 +44,383 @@ func getSportQueries() map[string]string {
 				home_team,
 				away_team,
 				advertised_start_time,
-				betting_closed_time
+				betting_closed_time,
+				EXISTS (SELECT 1 FROM results WHERE results.event_id = sports.id AND results.final = 1) AS finished,
+				COALESCE(competition_id, 0) AS competition_id,
+				COALESCE((SELECT name FROM competitions WHERE competitions.id = sports.competition_id), '') AS competition,
+				COALESCE(venue_id, 0) AS venue_id,
+				` + seasonExpression + ` AS season,
+				COALESCE(suspension_reason, '') AS suspension_reason,
+				COALESCE(suspended_by, '') AS suspended_by,
+				suspended_at
 			FROM sports
 			WHERE id= ?
 		`,
 	}
 }
+
+const (
+	resultByEventId   = "tuple"
+	resultRevisions   = "revisions"
+	resultUpsert      = "upsert"
+	resultRevisionAdd = "revision"
+	resultsHeadToHead = "head-to-head"
+	resultsTeamName   = "team"
+)
+
+func getResultQueries() map[string]string {
+	return map[string]string{
+		resultByEventId: `
+			SELECT
+				event_id,
+				home_score,
+				away_score,
+				outcome,
+				winner,
+				period_scores,
+				final,
+				revision,
+				reason,
+				recorded_at
+			FROM results
+			WHERE event_id = ?
+		`,
+		resultRevisions: `
+			SELECT
+				event_id,
+				home_score,
+				away_score,
+				outcome,
+				winner,
+				period_scores,
+				final,
+				revision,
+				reason,
+				recorded_at
+			FROM result_revisions
+			WHERE event_id = ?
+			ORDER BY revision
+		`,
+		resultUpsert: `
+			INSERT OR REPLACE INTO results (event_id, home_score, away_score, outcome, winner, period_scores, final, revision, reason, recorded_at)
+			VALUES (?,?,?,?,?,?,?,?,?,?)
+		`,
+		resultsHeadToHead: `
+			SELECT
+				sports.id,
+				sports.name,
+				COALESCE((SELECT name FROM competitions WHERE competitions.id = sports.competition_id), ''),
+				sports.advertised_start_time,
+				sports.home_team,
+				sports.away_team,
+				results.home_score,
+				results.away_score,
+				results.outcome,
+				results.winner
+			FROM sports
+			JOIN results ON results.event_id = sports.id AND results.final = 1
+			WHERE
+				(sports.home_team = ?1 COLLATE NOCASE AND sports.away_team = ?2 COLLATE NOCASE) OR
+				(sports.home_team = ?2 COLLATE NOCASE AND sports.away_team = ?1 COLLATE NOCASE)
+			ORDER BY sports.advertised_start_time DESC
+		`,
+		// A team name is resolved like the fixtures resolve it, the team names first and then the aliases. Unknown names are
+		// returned as they are.
+		resultsTeamName: `
+			SELECT COALESCE(
+				(SELECT name FROM teams WHERE name = ?1),
+				(SELECT teams.name FROM team_aliases JOIN teams ON teams.id = team_aliases.team_id WHERE team_aliases.alias = ?1),
+				?1
+			)
+		`,
+		resultRevisionAdd: `
+			INSERT INTO result_revisions (event_id, home_score, away_score, outcome, winner, period_scores, final, revision, reason, recorded_at)
+			VALUES (?,?,?,?,?,?,?,?,?,?)
+		`,
+	}
+}
+
+const (
+	fixtureBySourceUid = "fixture"
+	fixtureInsert      = "insert"
+	fixtureUpdate      = "update"
+	fixturePoints      = "points"
+)
+
+func getFixtureQueries() map[string]string {
+	return map[string]string{
+		fixtureBySourceUid: `
+			SELECT
+				id,
+				name,
+				home_team,
+				away_team,
+				advertised_start_time,
+				betting_closed_time,
+				COALESCE(competition_id, 0),
+				COALESCE(venue_id, 0),
+				COALESCE(season, '')
+			FROM sports
+			WHERE source_uid = ?
+		`,
+		fixtureInsert: `
+			INSERT INTO sports (meeting_id, name, number, visible, home_team, away_team, advertised_start_time, betting_closed_time, competition_id, venue_id, season, source_uid)
+			VALUES (0, ?, 0, 1, ?, ?, ?, ?, ?, ?, ?, ?)
+		`,
+		fixtureUpdate: `
+			UPDATE sports
+			SET name = ?, home_team = ?, away_team = ?, advertised_start_time = ?, betting_closed_time = ?, competition_id = ?, venue_id = ?, season = ?
+			WHERE id = ?
+		`,
+		// Nothing is updated when the rules are the same, so that the standings are only rebuilt when they change.
+		fixturePoints: `
+			UPDATE competitions
+			SET points_win = ?1, points_draw = ?2, points_loss = ?3
+			WHERE id = ?4 AND (points_win IS NOT ?1 OR points_draw IS NOT ?2 OR points_loss IS NOT ?3)
+		`,
+	}
+}
+
+const (
+	incidentsList  = "list"
+	incidentInsert = "insert"
+	incidentTypes  = "types"
+)
+
+func getIncidentQueries() map[string]string {
+	return map[string]string{
+		incidentsList: `
+			SELECT
+				id,
+				event_id,
+				sequence,
+				type,
+				period,
+				minute,
+				side,
+				team,
+				player,
+				description,
+				created_at
+			FROM incidents
+			WHERE event_id = ? AND sequence > ?
+			ORDER BY sequence
+		`,
+		// The sequence is assigned by the insert itself, as a concurrent insert could take it between a separate read and
+		// the insert.
+		incidentInsert: `
+			INSERT INTO incidents (event_id, sequence, type, period, minute, side, team, player, description, created_at)
+			SELECT ?, COALESCE(MAX(sequence), 0) + 1, ?, ?, ?, ?, ?, ?, ?, ?
+			FROM incidents
+			WHERE event_id = ?
+		`,
+		// The incident types of an event are the generic types plus the types of the sport of its competition.
+		incidentTypes: `
+			SELECT type
+			FROM incident_types
+			WHERE sport = '' OR sport = (
+				SELECT competitions.sport FROM sports JOIN competitions ON competitions.id = sports.competition_id WHERE sports.id = ?
+			)
+			ORDER BY type
+		`,
+	}
+}
+
+const (
+	standingsList    = "list"
+	standingsUpsert  = "upsert"
+	standingsSeason  = "season"
+	standingsRules   = "rules"
+	standingsEvent   = "event"
+	standingsResults = "results"
+	standingsDelete  = "delete"
+)
+
+func getStandingsQueries() map[string]string {
+	return map[string]string{
+		standingsList: `
+			SELECT
+				team,
+				played,
+				won,
+				drawn,
+				lost,
+				points_for,
+				points_against
+			FROM standings
+			WHERE competition_id = ? AND season = ? AND played > 0
+		`,
+		standingsUpsert: `
+			INSERT INTO standings (competition_id, season, team, played, won, drawn, lost, points_for, points_against)
+			VALUES (?,?,?,?,?,?,?,?,?)
+			ON CONFLICT (competition_id, season, team) DO UPDATE SET
+				played = played + excluded.played,
+				won = won + excluded.won,
+				drawn = drawn + excluded.drawn,
+				lost = lost + excluded.lost,
+				points_for = points_for + excluded.points_for,
+				points_against = points_against + excluded.points_against
+		`,
+		standingsSeason: `
+			SELECT MAX(` + seasonExpression + `)
+			FROM sports
+			WHERE competition_id = ?
+		`,
+		standingsRules: `
+			SELECT sport, points_win, points_draw, points_loss
+			FROM competitions
+			WHERE id = ?
+		`,
+		standingsEvent: `
+			SELECT
+				COALESCE(competition_id, 0),
+				` + seasonExpression + `,
+				home_team,
+				away_team
+			FROM sports
+			WHERE id = ?
+		`,
+		// A competition id of 0 is all the competitions.
+		standingsResults: `
+			SELECT results.event_id, results.home_score, results.away_score, results.final
+			FROM results
+			JOIN sports ON sports.id = results.event_id
+			WHERE results.final = 1 AND (?1 = 0 OR sports.competition_id = ?1)
+		`,
+		standingsDelete: `
+			DELETE FROM standings
+			WHERE ?1 = 0 OR competition_id = ?1
+		`,
+	}
+}
+
+const (
+	venuesByIds         = "list"
+	venueSeed           = "seed"
+	venueSeedSports     = "seed-sports"
+	venueImport         = "import"
+	venueNextImportedId = "next-imported-id"
+	venueMove           = "move"
+	venueMoveSports     = "move-sports"
+)
+
+func getVenueQueries() map[string]string {
+	return map[string]string{
+		venuesByIds: `
+			SELECT
+				id,
+				name,
+				COALESCE(city, ''),
+				COALESCE(country, ''),
+				COALESCE(latitude, 0),
+				COALESCE(longitude, 0),
+				COALESCE(timezone, '')
+			FROM venues
+		`,
+		// Seeding fills in the details of a venue that was created without them.
+		venueSeed: `
+			INSERT INTO venues (id, name, city, country, latitude, longitude, timezone)
+			VALUES (?, ?, ?, ?, ?, ?, ?)
+			ON CONFLICT (id) DO UPDATE SET
+				city = COALESCE(venues.city, excluded.city),
+				country = COALESCE(venues.country, excluded.country),
+				latitude = COALESCE(venues.latitude, excluded.latitude),
+				longitude = COALESCE(venues.longitude, excluded.longitude),
+				timezone = COALESCE(venues.timezone, excluded.timezone)
+		`,
+		venueSeedSports: `
+			UPDATE sports
+			SET venue_id = ?
+			WHERE venue_id IS NULL AND source_uid IS NULL AND id % ? = ?
+		`,
+		// The venues of the catalogue are seeded first, so an imported venue is only created when it isn't in the catalogue.
+		venueImport: `
+			INSERT OR IGNORE INTO venues (id, name)
+			SELECT MAX(COALESCE(MAX(id), 0) + 1, ?), ? FROM venues
+		`,
+		venueNextImportedId: `
+			SELECT MAX(COALESCE(MAX(id), 0) + 1, ?) FROM venues
+		`,
+		venueMove: `
+			UPDATE venues SET id = ? WHERE id = ?
+		`,
+		venueMoveSports: `
+			UPDATE sports SET venue_id = ? WHERE venue_id = ?
+		`,
+	}
+}
+
+const (
+	marketsList       = "list"
+	marketById        = "tuple"
+	marketsSeed       = "seed"
+	suspensionHistory = "history"
+)
+
+func getMarketQueries() map[string]string {
+	return map[string]string{
+		marketsList: `
+			SELECT
+				markets.id,
+				markets.event_id,
+				markets.name,
+				COALESCE(markets.suspension_reason, ''),
+				COALESCE(markets.suspended_by, ''),
+				markets.suspended_at,
+				sports.suspended_at IS NOT NULL
+			FROM markets
+			JOIN sports ON sports.id = markets.event_id
+			WHERE markets.event_id = ?
+			ORDER BY markets.id
+		`,
+		marketById: `
+			SELECT
+				markets.id,
+				markets.event_id,
+				markets.name,
+				COALESCE(markets.suspension_reason, ''),
+				COALESCE(markets.suspended_by, ''),
+				markets.suspended_at,
+				sports.suspended_at IS NOT NULL
+			FROM markets
+			JOIN sports ON sports.id = markets.event_id
+			WHERE markets.event_id = ? AND markets.id = ?
+		`,
+		// Every sport without markets gets the default markets.
+		marketsSeed: `
+			INSERT INTO markets (event_id, name)
+			SELECT sports.id, defaults.column1
+			FROM sports, (VALUES ('Head to Head'), ('Line'), ('Total Points')) AS defaults
+			WHERE NOT EXISTS (SELECT 1 FROM markets WHERE markets.event_id = sports.id)
+			ORDER BY sports.id
+		`,
+		suspensionHistory: `
+			INSERT INTO suspension_history (event_id, market_id, suspended, reason, actor, created_at)
+			VALUES (?, ?, ?, ?, ?, ?)
+		`,
+	}
+}
+
+const (
+	outrightsList  = "list"
+	outrightInsert = "insert"
+)
+
+func getOutrightQueries() map[string]string {
+	return map[string]string{
+		outrightsList: `
+			SELECT
+				outrights.id,
+				outrights.competition_id,
+				COALESCE(competitions.name, ''),
+				outrights.season,
+				` + outrights.Columns + `
+			FROM outrights
+			LEFT JOIN competitions ON competitions.id = outrights.competition_id
+		`,
+		outrightInsert: `
+			INSERT INTO outrights (competition_id, season, name, close_time)
+			VALUES (?, ?, ?, ?)
+		`,
+	}
+}
@@ -0,0 +1,303 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"git.neds.sh/matty/entain/sports/proto/sports"
+)
+
+// ResultsRepo provides repository access to sports event results.
+type ResultsRepo interface {
+	// Init will initialise our results repository.
+	Init() error
+
+	// Get returns the latest result revision of a sports event. It'll return nil if no result has been recorded yet.
+	Get(ctx context.Context, eventId int64) (*sports.EventResult, error)
+
+	// ListRevisions returns all the result revisions of a sports event, oldest first.
+	ListRevisions(ctx context.Context, eventId int64) ([]*sports.EventResult, error)
+
+	// Record stores the given result as the next revision of the sports event result.
+	Record(ctx context.Context, result *sports.EventResult) (*sports.EventResult, error)
+
+	// ListHeadToHead returns the meetings with a final result between the two teams, latest first.
+	// The teams can be given by name or alias and their canonical names are returned along with the meetings.
+	ListHeadToHead(ctx context.Context, teamA string, teamB string) (string, string, []*sports.HeadToHeadMeeting, error)
+}
+
+type resultsRepo struct {
+	db   *sql.DB
+	init sync.Once
+}
+
+// periodScore is the JSON representation of a period score stored in the period_scores column.
+type periodScore struct {
+	Period    int64 `json:"period"`
+	HomeScore int64 `json:"home_score"`
+	AwayScore int64 `json:"away_score"`
+}
+
+// NewResultsRepo creates a new results repository.
+func NewResultsRepo(db *sql.DB) ResultsRepo {
+	return &resultsRepo{db: db}
+}
+
+// Init creates the results tables if they don't exist.
+func (r *resultsRepo) Init() error {
+	var err error
+
+	r.init.Do(func() {
+		err = r.createTables()
+	})
+
+	return err
+}
+
+func (r *resultsRepo) createTables() error {
+	// results holds the latest revision of each result and result_revisions holds the full history.
+	for _, table := range []string{"results", "result_revisions"} {
+		primaryKey := "PRIMARY KEY (event_id)"
+		if table == "result_revisions" {
+			primaryKey = "PRIMARY KEY (event_id, revision)"
+		}
+
+		if _, err := r.db.Exec(`CREATE TABLE IF NOT EXISTS ` + table + ` (event_id INTEGER, home_score INTEGER, away_score INTEGER, outcome INTEGER, winner TEXT, period_scores TEXT, final INTEGER, revision INTEGER, reason TEXT, recorded_at DATETIME, ` + primaryKey + `)`); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Get the latest result of a sports event
+func (r *resultsRepo) Get(ctx context.Context, eventId int64) (*sports.EventResult, error) {
+	query := getResultQueries()[resultByEventId]
+
+	ctx, span := startQuerySpan(ctx, "results.Get", query)
+
+	result, err := scanResult(r.db.QueryRowContext(ctx, query, eventId))
+	if err == sql.ErrNoRows {
+		result, err = nil, nil
+	}
+
+	endQuerySpan(span, err)
+
+	return result, err
+}
+
+// Get the full revision history of a sports event result
+func (r *resultsRepo) ListRevisions(ctx context.Context, eventId int64) (revisions []*sports.EventResult, err error) {
+	query := getResultQueries()[resultRevisions]
+
+	ctx, span := startQuerySpan(ctx, "results.ListRevisions", query)
+	defer func() { endQuerySpan(span, err) }()
+
+	rows, err := r.db.QueryContext(ctx, query, eventId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		result, err := scanResult(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		revisions = append(revisions, result)
+	}
+
+	return revisions, rows.Err()
+}
+
+// Record the given result as a new revision. The revision number and the recorded time are assigned here.
+//
+// The revision history, the latest result and the standings are updated in a single transaction so that they never go out of sync.
+func (r *resultsRepo) Record(ctx context.Context, result *sports.EventResult) (_ *sports.EventResult, err error) {
+	ctx, span := startQuerySpan(ctx, "results.Record", "")
+	defer func() { endQuerySpan(span, err) }()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var revision int64
+	if err := tx.QueryRowContext(ctx, `SELECT COALESCE(MAX(revision), 0) FROM result_revisions WHERE event_id = ?`, result.EventId).Scan(&revision); err != nil {
+		return nil, err
+	}
+
+	previous, err := scanResult(tx.QueryRowContext(ctx, getResultQueries()[resultByEventId], result.EventId))
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	recordedAt := time.Now().UTC().Truncate(time.Second)
+
+	result.Revision = revision + 1
+	result.RecordedAt, err = ptypes.TimestampProto(recordedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	periodScores, err := marshalPeriodScores(result.PeriodScores)
+	if err != nil {
+		return nil, err
+	}
+
+	args := []interface{}{
+		result.EventId,
+		result.HomeScore,
+		result.AwayScore,
+		int32(result.Outcome),
+		result.Winner,
+		periodScores,
+		result.Final,
+		result.Revision,
+		result.Reason,
+		recordedAt.Format(time.RFC3339),
+	}
+
+	if _, err := tx.ExecContext(ctx, getResultQueries()[resultRevisionAdd], args...); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, getResultQueries()[resultUpsert], args...); err != nil {
+		return nil, err
+	}
+
+	// Replace the contribution of the previous revision to the standings with the new one.
+	if err := applyResultToStandings(ctx, tx, previous, -1); err != nil {
+		return nil, err
+	}
+
+	if err := applyResultToStandings(ctx, tx, result, 1); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Get the meetings between two teams
+func (r *resultsRepo) ListHeadToHead(ctx context.Context, teamA string, teamB string) (_ string, _ string, _ []*sports.HeadToHeadMeeting, err error) {
+	ctx, span := startQuerySpan(ctx, "results.ListHeadToHead", "")
+	defer func() { endQuerySpan(span, err) }()
+
+	if err := r.db.QueryRowContext(ctx, getResultQueries()[resultsTeamName], normaliseName(teamA)).Scan(&teamA); err != nil {
+		return "", "", nil, err
+	}
+
+	if err := r.db.QueryRowContext(ctx, getResultQueries()[resultsTeamName], normaliseName(teamB)).Scan(&teamB); err != nil {
+		return "", "", nil, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, getResultQueries()[resultsHeadToHead], teamA, teamB)
+	if err != nil {
+		return "", "", nil, err
+	}
+	defer rows.Close()
+
+	var meetings []*sports.HeadToHeadMeeting
+
+	for rows.Next() {
+		var (
+			meeting         sports.HeadToHeadMeeting
+			advertisedStart time.Time
+			outcome         int32
+		)
+
+		if err := rows.Scan(&meeting.EventId, &meeting.Name, &meeting.Competition, &advertisedStart, &meeting.HomeTeam, &meeting.AwayTeam, &meeting.HomeScore, &meeting.AwayScore, &outcome, &meeting.Winner); err != nil {
+			return "", "", nil, err
+		}
+
+		meeting.Outcome = sports.EventResult_Outcome(outcome)
+
+		if meeting.AdvertisedStartTime, err = ptypes.TimestampProto(advertisedStart); err != nil {
+			return "", "", nil, err
+		}
+
+		meetings = append(meetings, &meeting)
+	}
+
+	return teamA, teamB, meetings, rows.Err()
+}
+
+// scanner is implemented by both *sql.Row and *sql.Rows.
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanResult(row scanner) (*sports.EventResult, error) {
+	var (
+		result       sports.EventResult
+		outcome      int32
+		periodScores string
+		recordedAt   time.Time
+	)
+
+	if err := row.Scan(&result.EventId, &result.HomeScore, &result.AwayScore, &outcome, &result.Winner, &periodScores, &result.Final, &result.Revision, &result.Reason, &recordedAt); err != nil {
+		return nil, err
+	}
+
+	result.Outcome = sports.EventResult_Outcome(outcome)
+
+	scores, err := unmarshalPeriodScores(periodScores)
+	if err != nil {
+		return nil, err
+	}
+
+	result.PeriodScores = scores
+
+	ts, err := ptypes.TimestampProto(recordedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	result.RecordedAt = ts
+
+	return &result, nil
+}
+
+func marshalPeriodScores(scores []*sports.PeriodScore) (string, error) {
+	stored := make([]periodScore, 0, len(scores))
+
+	for _, score := range scores {
+		stored = append(stored, periodScore{Period: score.Period, HomeScore: score.HomeScore, AwayScore: score.AwayScore})
+	}
+
+	b, err := json.Marshal(stored)
+
+	return string(b), err
+}
+
+func unmarshalPeriodScores(value string) ([]*sports.PeriodScore, error) {
+	var (
+		stored []periodScore
+		scores []*sports.PeriodScore
+	)
+
+	if value == "" {
+		return nil, nil
+	}
+
+	if err := json.Unmarshal([]byte(value), &stored); err != nil {
+		return nil, err
+	}
+
+	for _, score := range stored {
+		scores = append(scores, &sports.PeriodScore{Period: score.Period, HomeScore: score.HomeScore, AwayScore: score.AwayScore})
+	}
+
+	return scores, nil
+}
@@ -1,6 +1,7 @@
 package db
 
 import (
+	"context"
 	"database/sql"
 	"strconv"
 	"strings"
@@ -19,10 +20,10 @@ type SportsRepo interface {
 	Init() error
 
 	// List will return a list of sports.
-	List(filter *sports.ListEventsRequestFilter, order_by *sports.ListEventsRequestOrderBy) ([]*sports.Sport, error)
+	List(ctx context.Context, filter *sports.ListEventsRequestFilter, order_by *sports.ListEventsRequestOrderBy) ([]*sports.Sport, error)
 
 	// Get sport details by id
-	GetSportById(sportId int64) (*sports.Sport, error)
+	GetSportById(ctx context.Context, sportId int64) (*sports.Sport, error)
 }
 
 type sportsRepo struct {
@@ -42,13 +43,19 @@ func (r *sportsRepo) Init() error {
 	r.init.Do(func() {
 		// For test/example purposes, we seed the DB with some dummy sports.
 		err = r.seed()
+		if err == nil {
+			err = r.migrate()
+		}
+		if err == nil {
+			err = r.seedVenues()
+		}
 	})
 
 	return err
 }
 
 // This is used in ListEvents method to return the sports events based on the filter and the order by clause
-func (r *sportsRepo) List(filter *sports.ListEventsRequestFilter, orderBy *sports.ListEventsRequestOrderBy) ([]*sports.Sport, error) {
+func (r *sportsRepo) List(ctx context.Context, filter *sports.ListEventsRequestFilter, orderBy *sports.ListEventsRequestOrderBy) ([]*sports.Sport, error) {
 	var (
 		err   error
 		query string
@@ -60,17 +67,23 @@ func (r *sportsRepo) List(filter *sports.ListEventsRequestFilter, orderBy *sport
 	query, args = r.applyFilter(query, filter)
 	query = r.applyOrderByClause(query, orderBy)
 
-	rows, err := r.db.Query(query, args...)
+	ctx, span := startQuerySpan(ctx, "sports.List", query)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
 
 	if err != nil {
+		endQuerySpan(span, err)
 		return nil, err
 	}
 
-	return r.scanSportsRows(rows)
+	sportEvents, err := r.scanSportsRows(ctx, rows)
+	endQuerySpan(span, err)
+
+	return sportEvents, err
 }
 
 // Get a single sport by id
-func (r *sportsRepo) GetSportById(sportId int64) (*sports.Sport, error) {
+func (r *sportsRepo) GetSportById(ctx context.Context, sportId int64) (*sports.Sport, error) {
 	var (
 		query string
 		args  []interface{}
@@ -80,9 +93,14 @@ func (r *sportsRepo) GetSportById(sportId int64) (*sports.Sport, error) {
 
 	args = append(args, sportId)
 
-	row := r.db.QueryRow(query, args...)
+	ctx, span := startQuerySpan(ctx, "sports.GetSportById", query)
+
+	row := r.db.QueryRowContext(ctx, query, args...)
+
+	sport, err := r.scanSportsRow(ctx, row)
+	endQuerySpan(span, err)
 
-	return r.scanSportsRow(row)
+	return sport, err
 }
 
 func (r *sportsRepo) applyFilter(query string, filter *sports.ListEventsRequestFilter) (string, []interface{}) {
@@ -107,6 +125,20 @@ func (r *sportsRepo) applyFilter(query string, filter *sports.ListEventsRequestF
 		clauses = append(clauses, "visible = "+strconv.FormatBool(*filter.MeetingVisibility))
 	}
 
+	if len(filter.VenueIds) > 0 {
+		clauses = append(clauses, "venue_id IN ("+strings.Repeat("?,", len(filter.VenueIds)-1)+"?)")
+
+		for _, venueId := range filter.VenueIds {
+			args = append(args, venueId)
+		}
+	}
+
+	// Countries are stored as upper case ISO 3166-1 alpha-2 codes.
+	if filter.Country != "" {
+		clauses = append(clauses, "venue_id IN (SELECT id FROM venues WHERE country = ?)")
+		args = append(args, strings.ToUpper(strings.TrimSpace(filter.Country)))
+	}
+
 	if len(clauses) != 0 {
 		query += " WHERE " + strings.Join(clauses, " AND ")
 	}
@@ -115,6 +147,7 @@ func (r *sportsRepo) applyFilter(query string, filter *sports.ListEventsRequestF
 }
 
 func (m *sportsRepo) scanSportsRows(
+	ctx context.Context,
 	rows *sql.Rows,
 ) ([]*sports.Sport, error) {
 	var sportEvents []*sports.Sport
@@ -122,8 +155,13 @@ func (m *sportsRepo) scanSportsRows(
 	for rows.Next() {
 		var sport sports.Sport
 		var advertisedStart time.Time
+		var bettingClosed time.Time
+		var finished bool
+		var venueId int64
+		var suspension sports.Suspension
+		var suspendedAt sql.NullTime
 
-		if err := rows.Scan(&sport.Id, &sport.MeetingId, &sport.Name, &sport.Number, &sport.Visible, &sport.HomeTeam, &sport.AwayTeam, &advertisedStart, &sport.BettingClosedTime); err != nil {
+		if err := rows.Scan(&sport.Id, &sport.MeetingId, &sport.Name, &sport.Number, &sport.Visible, &sport.HomeTeam, &sport.AwayTeam, &advertisedStart, &bettingClosed, &finished, &sport.CompetitionId, &sport.Competition, &venueId, &sport.Season, &suspension.Reason, &suspension.Actor, &suspendedAt); err != nil {
 			if err == sql.ErrNoRows {
 				return nil, nil
 			}
@@ -138,28 +176,54 @@ func (m *sportsRepo) scanSportsRows(
 
 		sport.AdvertisedStartTime = ts
 
-		// This will set Status based on the AdvertisedStartTime. Fake data inserted are not in UTC so we should check the local time.
-		if advertisedStart.After(time.Now()) || advertisedStart.Equal(time.Now()) {
-			sport.Status = "OPEN"
-		} else {
-			sport.Status = "CLOSED"
+		ts2, err := ptypes.TimestampProto(bettingClosed)
+		if err != nil {
+			return nil, err
+		}
+
+		sport.BettingClosedTime = ts2
+
+		sport.Status = sportStatus(advertisedStart, finished)
+
+		if venueId != 0 {
+			sport.Venue = &sports.Venue{Id: venueId}
 		}
 
+		if sport.Suspension, err = suspensionOf(&suspension, suspendedAt); err != nil {
+			return nil, err
+		}
+
+		sport.Suspended = sport.Suspension != nil
+
 		sportEvents = append(sportEvents, &sport)
 	}
 
+	// The errors of the query, e.g. a missing table, only show up once the rows are read.
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := m.setVenues(ctx, sportEvents); err != nil {
+		return nil, err
+	}
+
 	return sportEvents, nil
 }
 
 // This will try to read the record from the DB and if successful it'll also set the sport status. Otherwise it'll return an error
 func (m *sportsRepo) scanSportsRow(
+	ctx context.Context,
 	row *sql.Row,
 ) (*sports.Sport, error) {
 	var sport sports.Sport
 	var advertisedStart time.Time
 	var bettingClosed time.Time
+	var finished bool
+	var venueId int64
+	var suspension sports.Suspension
+	var suspendedAt sql.NullTime
 
-	if err := row.Scan(&sport.Id, &sport.MeetingId, &sport.Name, &sport.Number, &sport.Visible, &sport.HomeTeam, &sport.AwayTeam, &advertisedStart, &bettingClosed); err != nil {
+	if err := row.Scan(&sport.Id, &sport.MeetingId, &sport.Name, &sport.Number, &sport.Visible, &sport.HomeTeam, &sport.AwayTeam, &advertisedStart, &bettingClosed, &finished, &sport.CompetitionId, &sport.Competition, &venueId, &sport.Season, &suspension.Reason, &suspension.Actor, &suspendedAt); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
 		}
@@ -181,21 +245,49 @@ func (m *sportsRepo) scanSportsRow(
 
 	sport.BettingClosedTime = ts2
 
-	// This will set Status based on the AdvertisedStartTime. Fake data inserted are not in UTC so we should check the local time.
-	if advertisedStart.After(time.Now()) || advertisedStart.Equal(time.Now()) {
-		sport.Status = "OPEN"
-	} else {
-		sport.Status = "CLOSED"
+	sport.Status = sportStatus(advertisedStart, finished)
+
+	if venueId != 0 {
+		sport.Venue = &sports.Venue{Id: venueId}
+	}
+
+	if sport.Suspension, err = suspensionOf(&suspension, suspendedAt); err != nil {
+		return nil, err
+	}
+
+	sport.Suspended = sport.Suspension != nil
+
+	if err := m.setVenues(ctx, []*sports.Sport{&sport}); err != nil {
+		return nil, err
 	}
 
 	return &sport, nil
 }
 
+// This will derive the sport status. A sport with a final result is FINISHED, otherwise the status is based on the AdvertisedStartTime.
+func sportStatus(advertisedStart time.Time, finished bool) string {
+	if finished {
+		return "FINISHED"
+	}
+
+	// Fake data inserted are not in UTC so we should check the local time.
+	if advertisedStart.After(time.Now()) || advertisedStart.Equal(time.Now()) {
+		return "OPEN"
+	}
+
+	return "CLOSED"
+}
+
+// SportOrderByFields are the columns the sports events can be ordered by.
+var SportOrderByFields = []string{
+	"id", "meeting_id", "name", "number", "visible", "home_team", "away_team", "advertised_start_time",
+	"betting_closed_time", "finished", "competition_id", "competition", "season",
+}
+
 /* This will add an ORDER BY clause to the ListEvents query with the fileds specified in the request and their order by direction
 
-NOTE: It's better to return an error if the client sends an invalid column name in the order by expression.
-		Therefore the validity of the column name is not checked while adding the order by clause.
-		And query execution will fail if atleast one of the order by expressions have an invalid column name.
+NOTE: The fields aren't checked here, the service rejects the ones not in SportOrderByFields before the query is built.
+		Otherwise the query would fail with an SQL error, which isn't meant for the clients.
 */
 func (r *sportsRepo) applyOrderByClause(query string, orderBy *sports.ListEventsRequestOrderBy) string {
 	var expressions []string
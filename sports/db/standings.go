@@ -0,0 +1,249 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+
+	"git.neds.sh/matty/entain/sports/proto/sports"
+)
+
+// StandingsRepo provides repository access to the standings of competitions.
+//
+// The standings hold the games played, won, drawn and lost and the points for and against of each team.
+// They are kept up to date incrementally as results are recorded, see applyResultToStandings, and rebuilt when the points
+// rules of their competition change, see rebuildStandings.
+type StandingsRepo interface {
+	// Init will initialise our standings repository.
+	Init() error
+
+	// List returns the standings of the teams that played in a competition season, in no particular order.
+	List(ctx context.Context, competitionId int64, season string) ([]*sports.Standing, error)
+
+	// LatestSeason returns the latest season of a competition. It'll be empty if the competition has no sports.
+	LatestSeason(ctx context.Context, competitionId int64) (string, error)
+
+	// GetRules returns the points rules of a competition and the kind of sport played in it. The rules will be nil if the competition doesn't exist.
+	GetRules(ctx context.Context, competitionId int64) (*sports.PointsRules, string, error)
+}
+
+type standingsRepo struct {
+	db   *sql.DB
+	init sync.Once
+}
+
+// defaultPointsRules are the points rules of the competitions that don't have their own, by the kind of sport.
+var defaultPointsRules = map[string]*sports.PointsRules{
+	"soccer": {Win: 3, Draw: 1, Loss: 0},
+	"afl":    {Win: 4, Draw: 2, Loss: 0},
+	"rugby":  {Win: 4, Draw: 2, Loss: 0},
+	"":       {Win: 3, Draw: 1, Loss: 0},
+}
+
+// NewStandingsRepo creates a new standings repository.
+func NewStandingsRepo(db *sql.DB) StandingsRepo {
+	return &standingsRepo{db: db}
+}
+
+// Init creates the standings table if it doesn't exist and builds the standings of the results recorded before it existed.
+func (r *standingsRepo) Init() error {
+	var err error
+
+	r.init.Do(func() {
+		err = r.createTable()
+	})
+
+	return err
+}
+
+func (r *standingsRepo) createTable() error {
+	if _, err := r.db.Exec(`CREATE TABLE IF NOT EXISTS standings (competition_id INTEGER, season TEXT, team TEXT, played INTEGER, won INTEGER, drawn INTEGER, lost INTEGER, points_for INTEGER, points_against INTEGER, PRIMARY KEY (competition_id, season, team))`); err != nil {
+		return err
+	}
+
+	var count int64
+	if err := r.db.QueryRow(`SELECT COUNT(*) FROM standings`).Scan(&count); err != nil || count > 0 {
+		return err
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := rebuildStandings(context.Background(), tx, 0); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Get the standings of a competition season
+func (r *standingsRepo) List(ctx context.Context, competitionId int64, season string) (standings []*sports.Standing, err error) {
+	query := getStandingsQueries()[standingsList]
+
+	ctx, span := startQuerySpan(ctx, "standings.List", query)
+	defer func() { endQuerySpan(span, err) }()
+
+	rows, err := r.db.QueryContext(ctx, query, competitionId, season)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var standing sports.Standing
+
+		if err := rows.Scan(&standing.Team, &standing.Played, &standing.Won, &standing.Drawn, &standing.Lost, &standing.PointsFor, &standing.PointsAgainst); err != nil {
+			return nil, err
+		}
+
+		standings = append(standings, &standing)
+	}
+
+	return standings, rows.Err()
+}
+
+// Get the latest season of a competition
+func (r *standingsRepo) LatestSeason(ctx context.Context, competitionId int64) (string, error) {
+	var season sql.NullString
+
+	query := getStandingsQueries()[standingsSeason]
+
+	ctx, span := startQuerySpan(ctx, "standings.LatestSeason", query)
+
+	err := r.db.QueryRowContext(ctx, query, competitionId).Scan(&season)
+	endQuerySpan(span, err)
+
+	return season.String, err
+}
+
+// Get the points rules of a competition, falling back to the defaults of its sport
+func (r *standingsRepo) GetRules(ctx context.Context, competitionId int64) (_ *sports.PointsRules, _ string, err error) {
+	var (
+		sport           string
+		win, draw, loss sql.NullInt64
+	)
+
+	query := getStandingsQueries()[standingsRules]
+
+	ctx, span := startQuerySpan(ctx, "standings.GetRules", query)
+	defer func() { endQuerySpan(span, err) }()
+
+	err = r.db.QueryRowContext(ctx, query, competitionId).Scan(&sport, &win, &draw, &loss)
+	if err == sql.ErrNoRows {
+		return nil, "", nil
+	}
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	rules, ok := defaultPointsRules[sport]
+	if !ok {
+		rules = defaultPointsRules[""]
+	}
+
+	if win.Valid && draw.Valid && loss.Valid {
+		rules = &sports.PointsRules{Win: win.Int64, Draw: draw.Int64, Loss: loss.Int64}
+	}
+
+	return rules, sport, nil
+}
+
+// rebuildStandings rebuilds the standings of a competition from its final results, or the standings of all the
+// competitions when competitionId is 0.
+func rebuildStandings(ctx context.Context, tx *sql.Tx, competitionId int64) error {
+	if _, err := tx.ExecContext(ctx, getStandingsQueries()[standingsDelete], competitionId); err != nil {
+		return err
+	}
+
+	rows, err := tx.QueryContext(ctx, getStandingsQueries()[standingsResults], competitionId)
+	if err != nil {
+		return err
+	}
+
+	var results []*sports.EventResult
+
+	for rows.Next() {
+		var result sports.EventResult
+		if err := rows.Scan(&result.EventId, &result.HomeScore, &result.AwayScore, &result.Final); err != nil {
+			rows.Close()
+			return err
+		}
+
+		results = append(results, &result)
+	}
+
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, result := range results {
+		if err := applyResultToStandings(ctx, tx, result, 1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// applyResultToStandings adds (sign = 1) or removes (sign = -1) a final result to/from the standings of the competition season of its sport.
+//
+// Correcting a final result removes the previous revision and adds the new one, so the standings never need to be rebuilt from scratch.
+func applyResultToStandings(ctx context.Context, tx *sql.Tx, result *sports.EventResult, sign int64) error {
+	var (
+		competitionId      int64
+		season             string
+		homeTeam, awayTeam string
+	)
+
+	if result == nil || !result.Final {
+		return nil
+	}
+
+	err := tx.QueryRowContext(ctx, getStandingsQueries()[standingsEvent], result.EventId).Scan(&competitionId, &season, &homeTeam, &awayTeam)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	// Sports that don't belong to a competition have no standings.
+	if competitionId == 0 {
+		return nil
+	}
+
+	teams := []struct {
+		name     string
+		scored   int64
+		conceded int64
+	}{
+		{homeTeam, result.HomeScore, result.AwayScore},
+		{awayTeam, result.AwayScore, result.HomeScore},
+	}
+
+	for _, team := range teams {
+		var won, drawn, lost int64
+
+		switch {
+		case team.scored > team.conceded:
+			won = 1
+		case team.scored < team.conceded:
+			lost = 1
+		default:
+			drawn = 1
+		}
+
+		if _, err := tx.ExecContext(ctx, getStandingsQueries()[standingsUpsert], competitionId, season, team.name, sign, sign*won, sign*drawn, sign*lost, sign*team.scored, sign*team.conceded); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
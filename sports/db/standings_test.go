@@ -0,0 +1,218 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"git.neds.sh/matty/entain/sports/proto/sports"
+)
+
+// standing is a row of the standings, without the position that's only known once they're sorted.
+type standing struct {
+	team                                               string
+	played, won, drawn, lost, pointsFor, pointsAgainst int64
+}
+
+func TestStandings(t *testing.T) {
+	type step func(t *testing.T, s *standingsTest)
+
+	result := func(uid string, home, away int64, final bool) step {
+		return func(t *testing.T, s *standingsTest) {
+			if _, err := s.results.Record(context.Background(), &sports.EventResult{EventId: s.ids[uid], HomeScore: home, AwayScore: away, Final: final}); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	fixture := func(uid, home, away, competition string) step {
+		return func(t *testing.T, s *standingsTest) {
+			s.upsert(t, uid, home, away, competition)
+		}
+	}
+
+	tests := []struct {
+		name  string
+		steps []step
+		want  []standing
+	}{
+		{
+			name:  "home win",
+			steps: []step{result("afl-1", 90, 60, true)},
+			want: []standing{
+				{team: "Carlton", played: 1, won: 1, pointsFor: 90, pointsAgainst: 60},
+				{team: "Essendon", played: 1, lost: 1, pointsFor: 60, pointsAgainst: 90},
+			},
+		},
+		{
+			name:  "draw",
+			steps: []step{result("afl-1", 70, 70, true)},
+			want: []standing{
+				{team: "Carlton", played: 1, drawn: 1, pointsFor: 70, pointsAgainst: 70},
+				{team: "Essendon", played: 1, drawn: 1, pointsFor: 70, pointsAgainst: 70},
+			},
+		},
+		{
+			name:  "live score",
+			steps: []step{result("afl-1", 30, 20, false)},
+			want:  nil,
+		},
+		{
+			name:  "several games",
+			steps: []step{result("afl-1", 90, 60, true), result("afl-2", 50, 80, true)},
+			want: []standing{
+				{team: "Carlton", played: 2, won: 1, lost: 1, pointsFor: 140, pointsAgainst: 140},
+				{team: "Essendon", played: 1, lost: 1, pointsFor: 60, pointsAgainst: 90},
+				{team: "Richmond", played: 1, won: 1, pointsFor: 80, pointsAgainst: 50},
+			},
+		},
+		{
+			name:  "corrected result",
+			steps: []step{result("afl-1", 90, 60, true), result("afl-1", 60, 61, true)},
+			want: []standing{
+				{team: "Carlton", played: 1, lost: 1, pointsFor: 60, pointsAgainst: 61},
+				{team: "Essendon", played: 1, won: 1, pointsFor: 61, pointsAgainst: 60},
+			},
+		},
+		{
+			name:  "final result reopened",
+			steps: []step{result("afl-1", 90, 60, true), result("afl-1", 90, 60, false)},
+			want:  nil,
+		},
+		{
+			name:  "live score then final result",
+			steps: []step{result("afl-1", 30, 20, false), result("afl-1", 90, 60, true)},
+			want: []standing{
+				{team: "Carlton", played: 1, won: 1, pointsFor: 90, pointsAgainst: 60},
+				{team: "Essendon", played: 1, lost: 1, pointsFor: 60, pointsAgainst: 90},
+			},
+		},
+		{
+			name:  "team of a finished game changed",
+			steps: []step{result("afl-1", 90, 60, true), fixture("afl-1", "Carlton", "Richmond", "AFL")},
+			want: []standing{
+				{team: "Carlton", played: 1, won: 1, pointsFor: 90, pointsAgainst: 60},
+				{team: "Richmond", played: 1, lost: 1, pointsFor: 60, pointsAgainst: 90},
+			},
+		},
+		{
+			name:  "finished game moved to another competition",
+			steps: []step{result("afl-1", 90, 60, true), fixture("afl-1", "Carlton", "Essendon", "AFLW")},
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newStandingsTest(t)
+			s.upsert(t, "afl-1", "Carlton", "Essendon", "AFL")
+			s.upsert(t, "afl-2", "Carlton", "Richmond", "AFL")
+
+			for _, step := range tt.steps {
+				step(t, s)
+			}
+
+			if got := s.list(t); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("standings = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRebuildStandings(t *testing.T) {
+	ctx := context.Background()
+
+	s := newStandingsTest(t)
+	s.upsert(t, "afl-1", "Carlton", "Essendon", "AFL")
+	s.upsert(t, "afl-2", "Carlton", "Richmond", "AFL")
+	s.upsert(t, "aflw-1", "Carlton", "Essendon", "AFLW")
+
+	for _, result := range []*sports.EventResult{
+		{EventId: s.ids["afl-1"], HomeScore: 90, AwayScore: 60, Final: true},
+		{EventId: s.ids["afl-1"], HomeScore: 60, AwayScore: 90, Final: true},
+		{EventId: s.ids["afl-2"], HomeScore: 70, AwayScore: 70, Final: true},
+		{EventId: s.ids["aflw-1"], HomeScore: 40, AwayScore: 30, Final: true},
+	} {
+		if _, err := s.results.Record(ctx, result); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want := s.list(t)
+
+	if _, err := s.db.Exec(`UPDATE standings SET played = played + 10`); err != nil {
+		t.Fatal(err)
+	}
+
+	// Changing the points rules rebuilds the standings from the final results, which must match the incremental ones.
+	if err := s.fixtures.SetCompetitionPoints(ctx, "AFL", &sports.PointsRules{Win: 2, Draw: 1, Loss: 0}); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := s.list(t); !reflect.DeepEqual(got, want) {
+		t.Errorf("rebuilt standings = %+v, want %+v", got, want)
+	}
+}
+
+type standingsTest struct {
+	db        *sql.DB
+	fixtures  FixturesRepo
+	results   ResultsRepo
+	standings StandingsRepo
+	// ids are the sport ids by fixture uid.
+	ids map[string]int64
+	// competitionId is the id of the AFL competition.
+	competitionId int64
+}
+
+func newStandingsTest(t *testing.T) *standingsTest {
+	db := newTestDB(t)
+
+	return &standingsTest{
+		db:        db,
+		fixtures:  NewFixturesRepo(db),
+		results:   NewResultsRepo(db),
+		standings: NewStandingsRepo(db),
+		ids:       map[string]int64{},
+	}
+}
+
+// upsert imports a fixture of the 2021 season.
+func (s *standingsTest) upsert(t *testing.T, uid, home, away, competition string) {
+	start, err := ptypes.TimestampProto(time.Date(2021, 10, 2, 4, 30, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sport := &sports.Sport{Name: home + " vs " + away, HomeTeam: home, AwayTeam: away, Competition: competition, AdvertisedStartTime: start, BettingClosedTime: start}
+	if _, err := s.fixtures.Upsert(context.Background(), uid, sport); err != nil {
+		t.Fatal(err)
+	}
+
+	s.ids[uid] = sport.Id
+	if competition == "AFL" {
+		s.competitionId = sport.CompetitionId
+	}
+}
+
+// list returns the AFL standings of the 2021 season by team.
+func (s *standingsTest) list(t *testing.T) []standing {
+	found, err := s.standings.List(context.Background(), s.competitionId, "2021")
+	if err != nil && err != sql.ErrNoRows {
+		t.Fatal(err)
+	}
+
+	var standings []standing
+	for _, f := range found {
+		standings = append(standings, standing{f.Team, f.Played, f.Won, f.Drawn, f.Lost, f.PointsFor, f.PointsAgainst})
+	}
+
+	sort.Slice(standings, func(i, j int) bool { return standings[i].team < standings[j].team })
+
+	return standings
+}
@@ -0,0 +1,192 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"git.neds.sh/matty/entain/common/venues"
+	"git.neds.sh/matty/entain/sports/proto/sports"
+)
+
+// seedVenues seeds the shared venues catalogue and spreads the dummy sports that don't have a venue across the stadiums.
+// Sports imported from fixtures are left alone.
+func (r *sportsRepo) seedVenues() error {
+	if err := r.renumberVenues(); err != nil {
+		return err
+	}
+
+	for _, venue := range venues.Catalogue() {
+		if _, err := r.db.Exec(getVenueQueries()[venueSeed], venue.Id, venue.Name, venue.City, venue.Country, venue.Latitude, venue.Longitude, venue.Timezone); err != nil {
+			return err
+		}
+	}
+
+	for i, venue := range venues.Stadiums {
+		if _, err := r.db.Exec(getVenueQueries()[venueSeedSports], venue.Id, len(venues.Stadiums), i); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// renumberVenues gives the venues of a database seeded before the venues catalogue was shared the ids of the catalogue,
+// and the venues that aren't in the catalogue ids from venues.FirstImportedId. The sports events move along with them.
+func (r *sportsRepo) renumberVenues() error {
+	rows, err := r.db.Query(`SELECT id, name FROM venues ORDER BY id`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	type move struct{ from, to int64 }
+
+	var moves []move
+
+	for rows.Next() {
+		var (
+			id   int64
+			name string
+		)
+
+		if err := rows.Scan(&id, &name); err != nil {
+			return err
+		}
+
+		venue, ok := venues.ByName(name)
+		switch {
+		case ok && id != venue.Id:
+			moves = append(moves, move{id, venue.Id})
+		case !ok && id < venues.FirstImportedId:
+			// The id is given once the other venues are out of the way.
+			moves = append(moves, move{id, 0})
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	if len(moves) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// The venues first move to negative ids, as the ids they move to may still be taken by another one that moves.
+	for _, m := range moves {
+		if err := moveVenue(tx, m.from, -m.from); err != nil {
+			return err
+		}
+	}
+
+	for _, m := range moves {
+		to := m.to
+		if to == 0 {
+			if err := tx.QueryRow(getVenueQueries()[venueNextImportedId], venues.FirstImportedId).Scan(&to); err != nil {
+				return err
+			}
+		}
+
+		if err := moveVenue(tx, -m.from, to); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// moveVenue changes the id of a venue, and of the venue of the sports events taking place at it.
+func moveVenue(tx *sql.Tx, from, to int64) error {
+	if _, err := tx.Exec(getVenueQueries()[venueMove], to, from); err != nil {
+		return err
+	}
+
+	_, err := tx.Exec(getVenueQueries()[venueMoveSports], to, from)
+
+	return err
+}
+
+// upsertVenue returns the id of the venue with the given name, creating it with the next id from venues.FirstImportedId
+// if it's neither in the catalogue nor imported already.
+func upsertVenue(ctx context.Context, db *sql.DB, name string) (int64, error) {
+	var id int64
+
+	name = normaliseName(name)
+
+	if _, err := db.ExecContext(ctx, getVenueQueries()[venueImport], venues.FirstImportedId, name); err != nil {
+		return 0, err
+	}
+
+	err := db.QueryRowContext(ctx, `SELECT id FROM venues WHERE name = ?`, name).Scan(&id)
+
+	return id, err
+}
+
+// setVenues replaces the venue ids scanned into the given sports with the venue details and sets their local advertised start time.
+// The venues are fetched in a single query.
+func (r *sportsRepo) setVenues(ctx context.Context, sportEvents []*sports.Sport) error {
+	var (
+		args []interface{}
+		seen = map[int64]bool{}
+	)
+
+	for _, sport := range sportEvents {
+		if sport.Venue != nil && !seen[sport.Venue.Id] {
+			seen[sport.Venue.Id] = true
+			args = append(args, sport.Venue.Id)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	rows, err := r.db.QueryContext(ctx, getVenueQueries()[venuesByIds]+" WHERE id IN ("+strings.Repeat("?,", len(args)-1)+"?)", args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	byId := map[int64]*sports.Venue{}
+
+	for rows.Next() {
+		var venue sports.Venue
+
+		if err := rows.Scan(&venue.Id, &venue.Name, &venue.City, &venue.Country, &venue.Latitude, &venue.Longitude, &venue.Timezone); err != nil {
+			return err
+		}
+
+		byId[venue.Id] = &venue
+	}
+
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, sport := range sportEvents {
+		if sport.Venue == nil {
+			continue
+		}
+
+		sport.Venue = byId[sport.Venue.Id]
+		sport.AdvertisedStartLocalTime = localTime(sport.AdvertisedStartTime.AsTime(), sport.Venue)
+	}
+
+	return nil
+}
+
+// localTime formats the time in the time zone of the venue. It's empty if the venue or its time zone isn't known.
+func localTime(t time.Time, venue *sports.Venue) string {
+	if venue == nil {
+		return ""
+	}
+
+	return venues.LocalTime(t, venue.Timezone)
+}
@@ -0,0 +1,135 @@
+package fixtures
+
+import (
+	"crypto/sha1"
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// csvColumns maps the accepted CSV header names to the fixture fields.
+var csvColumns = map[string]string{
+	"uid":         "uid",
+	"id":          "uid",
+	"name":        "name",
+	"home":        "home",
+	"home_team":   "home",
+	"away":        "away",
+	"away_team":   "away",
+	"venue":       "venue",
+	"location":    "venue",
+	"start":       "start",
+	"start_time":  "start",
+	"competition": "competition",
+	"sport":       "sport",
+	"season":      "season",
+}
+
+// csvTimeLayouts are the accepted start time formats. Times without an offset are read in the configured location.
+var csvTimeLayouts = []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02 15:04", "2006-01-02T15:04:05", "2006-01-02T15:04"}
+
+// ParseCSV reads fixtures from a CSV file with a header row.
+//
+// The home, away and start columns are required. Fixtures without a uid are given one derived from the competition, teams and start time,
+// so re-importing the same row still matches the sport created the first time.
+func ParseCSV(r io.Reader, opts Options) ([]*Fixture, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed reading the csv header: %w", err)
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		if field, ok := csvColumns[strings.ToLower(strings.TrimSpace(name))]; ok {
+			columns[field] = i
+		}
+	}
+
+	for _, required := range []string{"home", "away", "start"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("the csv header has no %s column", required)
+		}
+	}
+
+	var fixtures []*Fixture
+
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			fixtures = append(fixtures, &Fixture{Row: line, Err: err})
+			continue
+		}
+
+		fixtures = append(fixtures, csvFixture(line, columns, record, opts))
+	}
+
+	return fixtures, nil
+}
+
+func csvFixture(line int, columns map[string]int, record []string, opts Options) *Fixture {
+	value := func(field string) string {
+		if i, ok := columns[field]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+
+		return ""
+	}
+
+	fixture := &Fixture{
+		Row:         line,
+		UID:         value("uid"),
+		Name:        value("name"),
+		HomeTeam:    value("home"),
+		AwayTeam:    value("away"),
+		Venue:       value("venue"),
+		Competition: value("competition"),
+		Sport:       value("sport"),
+		Season:      value("season"),
+	}
+
+	if fixture.Competition == "" {
+		fixture.Competition = opts.Competition
+	}
+
+	if fixture.Sport == "" {
+		fixture.Sport = opts.Sport
+	}
+
+	if fixture.Season == "" {
+		fixture.Season = opts.Season
+	}
+
+	if start := value("start"); start != "" {
+		for _, layout := range csvTimeLayouts {
+			if t, err := time.ParseInLocation(layout, start, opts.Location); err == nil {
+				fixture.StartTime = t
+				break
+			}
+		}
+
+		if fixture.StartTime.IsZero() {
+			fixture.Err = fmt.Errorf("invalid start time %q", start)
+			return fixture
+		}
+	}
+
+	if fixture.UID == "" && fixture.HomeTeam != "" && fixture.AwayTeam != "" && !fixture.StartTime.IsZero() {
+		sum := sha1.Sum([]byte(strings.ToLower(strings.Join([]string{fixture.Competition, fixture.HomeTeam, fixture.AwayTeam, fixture.StartTime.UTC().Format(time.RFC3339)}, "|"))))
+		fixture.UID = "csv-" + hex.EncodeToString(sum[:])
+	}
+
+	fixture.Err = fixture.validate()
+
+	return fixture
+}
@@ -0,0 +1,134 @@
+package fixtures
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseCSV(t *testing.T) {
+	melbourne, err := time.LoadLocation("Australia/Melbourne")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts := Options{Competition: "AFL", Sport: "afl", Location: melbourne}
+
+	tests := []struct {
+		name    string
+		file    string
+		want    Fixture
+		wantErr bool
+	}{
+		{
+			name: "all columns",
+			file: `uid,name,home_team,away_team,venue,start_time,competition,sport,season
+nrl-1,Round 1,Storm,Broncos,AAMI Park,2022-03-10T19:30:00+11:00,NRL,rugby league,2022`,
+			want: Fixture{UID: "nrl-1", Name: "Round 1", HomeTeam: "Storm", AwayTeam: "Broncos", Venue: "AAMI Park", Competition: "NRL", Sport: "rugby league", Season: "2022",
+				StartTime: time.Date(2022, 3, 10, 8, 30, 0, 0, time.UTC)},
+		},
+		{
+			name: "aliased columns in any order and case",
+			file: `Start, Away, Home, ID, Location
+2021-10-02 14:30, Essendon, Carlton, afl-1, MCG`,
+			want: Fixture{UID: "afl-1", HomeTeam: "Carlton", AwayTeam: "Essendon", Venue: "MCG", Competition: "AFL", Sport: "afl",
+				StartTime: time.Date(2021, 10, 2, 14, 30, 0, 0, melbourne)},
+		},
+		// The uid mustn't change between releases, otherwise the fixtures imported before are duplicated.
+		{
+			name: "generated uid",
+			file: `home,away,start
+Carlton,Essendon,2021-10-02T14:30`,
+			want: Fixture{UID: "csv-5221891139cbbb2b0baf7e4b1923bebc649f96ff", HomeTeam: "Carlton", AwayTeam: "Essendon", Competition: "AFL", Sport: "afl",
+				StartTime: time.Date(2021, 10, 2, 14, 30, 0, 0, melbourne)},
+		},
+		{
+			name: "invalid start time",
+			file: `uid,home,away,start
+afl-1,Carlton,Essendon,tomorrow`,
+			wantErr: true,
+		},
+		{
+			name: "no away team",
+			file: `uid,home,away,start
+afl-1,Carlton,,2021-10-02 14:30`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fixtures, err := ParseCSV(strings.NewReader(tt.file), opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(fixtures) != 1 {
+				t.Fatalf("ParseCSV() returned %d fixtures, want 1", len(fixtures))
+			}
+
+			got := fixtures[0]
+			if (got.Err != nil) != tt.wantErr {
+				t.Fatalf("ParseCSV() fixture error = %v, wantErr %v", got.Err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			tt.want.Row = 2
+			assertFixture(t, got, &tt.want)
+		})
+	}
+}
+
+func TestParseCSVGeneratedUIDIsStable(t *testing.T) {
+	parse := func(file string) string {
+		fixtures, err := ParseCSV(strings.NewReader(file), Options{Competition: "AFL", Location: time.UTC})
+		if err != nil || len(fixtures) != 1 {
+			t.Fatalf("ParseCSV() = %v, %v", fixtures, err)
+		}
+
+		return fixtures[0].UID
+	}
+
+	first := parse("home,away,start\nCarlton,Essendon,2021-10-02T04:30:00Z")
+
+	tests := []struct {
+		name   string
+		file   string
+		wantEq bool
+	}{
+		{name: "same fixture in another case and time zone", file: "home,away,start\ncarlton,ESSENDON,2021-10-02T14:30:00+10:00", wantEq: true},
+		{name: "another start time", file: "home,away,start\nCarlton,Essendon,2021-10-09T04:30:00Z", wantEq: false},
+		{name: "teams swapped", file: "home,away,start\nEssendon,Carlton,2021-10-02T04:30:00Z", wantEq: false},
+		{name: "another competition", file: "home,away,start,competition\nCarlton,Essendon,2021-10-02T04:30:00Z,AFLW", wantEq: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parse(tt.file); (got == first) != tt.wantEq {
+				t.Errorf("uid = %q, first uid = %q, want equal %v", got, first, tt.wantEq)
+			}
+		})
+	}
+}
+
+func TestParseCSVHeader(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+	}{
+		{name: "empty", file: ""},
+		{name: "no home column", file: "away,start\nEssendon,2021-10-02 14:30"},
+		{name: "no start column", file: "home,away\nCarlton,Essendon"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseCSV(strings.NewReader(tt.file), Options{Location: time.UTC}); err == nil {
+				t.Error("ParseCSV() error = nil, want an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package fixtures
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Fixture is a single scheduled sports event read from a fixture file.
+type Fixture struct {
+	// Row is the position of the fixture in the file. It's the line number for CSV files and the VEVENT number for iCalendar files.
+	Row int
+	// UID identifies the fixture in the source it was published by.
+	UID         string
+	Name        string
+	HomeTeam    string
+	AwayTeam    string
+	Venue       string
+	Competition string
+	// Sport is the kind of sport (soccer, afl etc.) played in the competition.
+	Sport string
+	// Season of the competition. The year of the start time, in UTC, is used when it's empty.
+	Season    string
+	StartTime time.Time
+	// Err is set when the fixture couldn't be read. Such fixtures are reported and skipped by the importer.
+	Err error
+}
+
+// Options controls how fixture files are read.
+type Options struct {
+	// Competition is used for fixtures that don't specify a competition.
+	Competition string
+	// Sport is used for fixtures that don't specify the kind of sport.
+	Sport string
+	// Season is used for fixtures that don't specify a season.
+	Season string
+	// Location is used for start times that don't specify a time zone.
+	Location *time.Location
+}
+
+// ParseFile reads the fixtures from an iCalendar (.ics) or a CSV (.csv) file.
+func ParseFile(path string, opts Options) ([]*Fixture, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if opts.Location == nil {
+		opts.Location = time.UTC
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".ics", ".ical", ".ifb":
+		return ParseICal(f, opts)
+	case ".csv":
+		return ParseCSV(f, opts)
+	default:
+		return nil, fmt.Errorf("unsupported fixture file %q, expected a .ics or .csv file", path)
+	}
+}
+
+// validate checks that the fixture has everything needed to create a sports event.
+func (f *Fixture) validate() error {
+	switch {
+	case f.HomeTeam == "" || f.AwayTeam == "":
+		return fmt.Errorf("home and away teams are required")
+	case f.StartTime.IsZero():
+		return fmt.Errorf("start time is required")
+	case f.UID == "":
+		return fmt.Errorf("uid is required")
+	}
+
+	return nil
+}
+
+// splitTeams splits an event title like "Home vs Away" into the home and away team names.
+func splitTeams(title string) (string, string, bool) {
+	for _, separator := range []string{" vs. ", " vs ", " v ", " - "} {
+		if i := indexFold(title, separator); i > 0 {
+			return strings.TrimSpace(title[:i]), strings.TrimSpace(title[i+len(separator):]), true
+		}
+	}
+
+	// US style "Away @ Home" titles list the away team first.
+	if i := strings.Index(title, " @ "); i > 0 {
+		return strings.TrimSpace(title[i+3:]), strings.TrimSpace(title[:i]), true
+	}
+
+	return "", "", false
+}
+
+// indexFold returns the index of the first case-insensitive match of the ASCII separator in the title, or -1. The title
+// is matched as is, as lowering it can change the length of its runes, e.g. "İ", and so the indexes.
+func indexFold(title, separator string) int {
+	for i := 0; i+len(separator) <= len(title); i++ {
+		if strings.EqualFold(title[i:i+len(separator)], separator) {
+			return i
+		}
+	}
+
+	return -1
+}
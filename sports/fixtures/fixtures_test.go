@@ -0,0 +1,34 @@
+package fixtures
+
+import "testing"
+
+func TestSplitTeams(t *testing.T) {
+	tests := []struct {
+		title    string
+		wantHome string
+		wantAway string
+		wantOk   bool
+	}{
+		{title: "Carlton vs Essendon", wantHome: "Carlton", wantAway: "Essendon", wantOk: true},
+		{title: "Carlton VS. Essendon", wantHome: "Carlton", wantAway: "Essendon", wantOk: true},
+		{title: "Carlton v Essendon", wantHome: "Carlton", wantAway: "Essendon", wantOk: true},
+		{title: "Carlton - Essendon", wantHome: "Carlton", wantAway: "Essendon", wantOk: true},
+		{title: "  Carlton   vs   Essendon  ", wantHome: "Carlton", wantAway: "Essendon", wantOk: true},
+		// The away team comes first in US style titles.
+		{title: "Lakers @ Celtics", wantHome: "Celtics", wantAway: "Lakers", wantOk: true},
+		// Lowering "İ" changes its length, which mustn't shift the split.
+		{title: "İstanbul Başakşehir vs Galatasaray", wantHome: "İstanbul Başakşehir", wantAway: "Galatasaray", wantOk: true},
+		{title: "Vasco da Gama vs Flamengo", wantHome: "Vasco da Gama", wantAway: "Flamengo", wantOk: true},
+		{title: "Grand Final", wantOk: false},
+		{title: "vs Essendon", wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			home, away, ok := splitTeams(tt.title)
+			if home != tt.wantHome || away != tt.wantAway || ok != tt.wantOk {
+				t.Errorf("splitTeams(%q) = %q, %q, %v, want %q, %q, %v", tt.title, home, away, ok, tt.wantHome, tt.wantAway, tt.wantOk)
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package fixtures
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// icalProperty is a single content line of an iCalendar file, e.g. DTSTART;TZID=Australia/Melbourne:20211002T143000
+type icalProperty struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// ParseICal reads the VEVENTs of an iCalendar (RFC 5545) file as fixtures.
+//
+// The teams are read from the X-HOME-TEAM and X-AWAY-TEAM properties when present, otherwise from a SUMMARY like "Home vs Away".
+// The venue is read from LOCATION, the competition from X-COMPETITION or the first of the CATEGORIES,
+// the season from X-SEASON and the kind of sport from X-SPORT.
+func ParseICal(r io.Reader, opts Options) ([]*Fixture, error) {
+	var (
+		fixtures []*Fixture
+		event    []icalProperty
+		inEvent  bool
+	)
+
+	lines, err := unfoldLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, line := range lines {
+		prop, ok := parseICalProperty(line)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case prop.name == "BEGIN" && strings.EqualFold(prop.value, "VEVENT"):
+			inEvent = true
+			event = nil
+		case prop.name == "END" && strings.EqualFold(prop.value, "VEVENT"):
+			inEvent = false
+			fixtures = append(fixtures, icalFixture(len(fixtures)+1, event, opts))
+		case inEvent:
+			event = append(event, prop)
+		}
+	}
+
+	return fixtures, nil
+}
+
+func icalFixture(row int, event []icalProperty, opts Options) *Fixture {
+	var competition, category string
+
+	fixture := &Fixture{Row: row, Sport: opts.Sport, Season: opts.Season}
+
+	for _, prop := range event {
+		switch prop.name {
+		case "UID":
+			fixture.UID = unescapeICalText(prop.value)
+		case "SUMMARY":
+			fixture.Name = unescapeICalText(prop.value)
+		case "LOCATION":
+			fixture.Venue = unescapeICalText(prop.value)
+		case "X-HOME-TEAM":
+			fixture.HomeTeam = unescapeICalText(prop.value)
+		case "X-AWAY-TEAM":
+			fixture.AwayTeam = unescapeICalText(prop.value)
+		case "X-SEASON":
+			fixture.Season = unescapeICalText(prop.value)
+		case "X-SPORT":
+			fixture.Sport = unescapeICalText(prop.value)
+		case "X-COMPETITION":
+			competition = unescapeICalText(prop.value)
+		case "CATEGORIES":
+			if category == "" {
+				category = unescapeICalText(splitICalList(prop.value)[0])
+			}
+		case "DTSTART":
+			start, err := parseICalTime(prop, opts.Location)
+			if err != nil {
+				fixture.Err = err
+				return fixture
+			}
+
+			fixture.StartTime = start
+		}
+	}
+
+	switch {
+	case competition != "":
+		fixture.Competition = competition
+	case category != "":
+		fixture.Competition = category
+	default:
+		fixture.Competition = opts.Competition
+	}
+
+	if fixture.HomeTeam == "" && fixture.AwayTeam == "" {
+		fixture.HomeTeam, fixture.AwayTeam, _ = splitTeams(fixture.Name)
+	}
+
+	fixture.Err = fixture.validate()
+
+	return fixture
+}
+
+// unfoldLines joins the long lines that were folded onto multiple lines. Folded lines start with a space or a tab.
+func unfoldLines(r io.Reader) ([]string, error) {
+	var lines []string
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+
+		lines = append(lines, line)
+	}
+
+	return lines, scanner.Err()
+}
+
+func parseICalProperty(line string) (icalProperty, bool) {
+	prop := icalProperty{params: map[string]string{}}
+
+	// The value starts after the first colon that isn't inside a quoted parameter value.
+	quoted := false
+	colon := -1
+	for i, c := range line {
+		if c == '"' {
+			quoted = !quoted
+		}
+
+		if c == ':' && !quoted {
+			colon = i
+			break
+		}
+	}
+
+	if colon < 0 {
+		return prop, false
+	}
+
+	parts := strings.Split(line[:colon], ";")
+	prop.name = strings.ToUpper(parts[0])
+	prop.value = line[colon+1:]
+
+	for _, param := range parts[1:] {
+		if kv := strings.SplitN(param, "=", 2); len(kv) == 2 {
+			prop.params[strings.ToUpper(kv[0])] = strings.Trim(kv[1], `"`)
+		}
+	}
+
+	return prop, true
+}
+
+// parseICalTime reads UTC (20211002T043000Z), local (20211002T143000 with an optional TZID) and date only (20211002) values.
+func parseICalTime(prop icalProperty, loc *time.Location) (time.Time, error) {
+	if tzid, ok := prop.params["TZID"]; ok {
+		l, err := time.LoadLocation(tzid)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("unknown time zone %q", tzid)
+		}
+
+		loc = l
+	}
+
+	layouts := []string{"20060102T150405", "20060102"}
+	if strings.HasSuffix(prop.value, "Z") {
+		layouts = []string{"20060102T150405Z"}
+		loc = time.UTC
+	}
+
+	for _, layout := range layouts {
+		if t, err := time.ParseInLocation(layout, prop.value, loc); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid start time %q", prop.value)
+}
+
+// splitICalList splits a list value, e.g. CATEGORIES, on the commas that aren't escaped. The values are left escaped.
+func splitICalList(value string) []string {
+	var (
+		values  []string
+		start   int
+		escaped bool
+	)
+
+	for i, c := range value {
+		switch {
+		case escaped:
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case c == ',':
+			values = append(values, value[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(values, value[start:])
+}
+
+func unescapeICalText(value string) string {
+	return strings.NewReplacer(`\n`, " ", `\N`, " ", `\,`, ",", `\;`, ";", `\\`, `\`).Replace(strings.TrimSpace(value))
+}
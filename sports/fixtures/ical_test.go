@@ -0,0 +1,164 @@
+package fixtures
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseICal(t *testing.T) {
+	melbourne, err := time.LoadLocation("Australia/Melbourne")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts := Options{Competition: "AFL", Sport: "afl", Season: "2021", Location: time.UTC}
+
+	tests := []struct {
+		name    string
+		event   string
+		want    Fixture
+		wantErr bool
+	}{
+		{
+			name: "summary",
+			event: `UID:afl-1
+SUMMARY:Carlton vs Essendon
+LOCATION:MCG\, Melbourne
+DTSTART:20211002T043000Z`,
+			want: Fixture{UID: "afl-1", Name: "Carlton vs Essendon", HomeTeam: "Carlton", AwayTeam: "Essendon", Venue: "MCG, Melbourne", Competition: "AFL", Sport: "afl", Season: "2021",
+				StartTime: time.Date(2021, 10, 2, 4, 30, 0, 0, time.UTC)},
+		},
+		{
+			name: "team properties",
+			event: `UID:nrl-1
+SUMMARY:Round 1
+X-HOME-TEAM:Storm
+X-AWAY-TEAM:Broncos
+X-COMPETITION:NRL
+X-SPORT:rugby league
+X-SEASON:2022
+DTSTART;TZID=Australia/Melbourne:20220310T193000`,
+			want: Fixture{UID: "nrl-1", Name: "Round 1", HomeTeam: "Storm", AwayTeam: "Broncos", Competition: "NRL", Sport: "rugby league", Season: "2022",
+				StartTime: time.Date(2022, 3, 10, 19, 30, 0, 0, melbourne)},
+		},
+		{
+			name: "folded lines",
+			event: `UID:afl-2
+SUMMARY:Western Bulldogs vs Port Adel
+ aide
+DTSTART:20211002T043000Z`,
+			want: Fixture{UID: "afl-2", Name: "Western Bulldogs vs Port Adelaide", HomeTeam: "Western Bulldogs", AwayTeam: "Port Adelaide", Competition: "AFL", Sport: "afl", Season: "2021",
+				StartTime: time.Date(2021, 10, 2, 4, 30, 0, 0, time.UTC)},
+		},
+		{
+			name: "escaped comma in the categories",
+			event: `UID:rugby-1
+SUMMARY:Wales vs Ireland
+CATEGORIES:Rugby\, Union Cup,International
+DTSTART:20211002T043000Z`,
+			want: Fixture{UID: "rugby-1", Name: "Wales vs Ireland", HomeTeam: "Wales", AwayTeam: "Ireland", Competition: "Rugby, Union Cup", Sport: "afl", Season: "2021",
+				StartTime: time.Date(2021, 10, 2, 4, 30, 0, 0, time.UTC)},
+		},
+		{
+			name: "competition over the categories",
+			event: `UID:afl-3
+SUMMARY:Carlton vs Essendon
+CATEGORIES:Football
+X-COMPETITION:AFLW
+DTSTART:20211002T043000Z`,
+			want: Fixture{UID: "afl-3", Name: "Carlton vs Essendon", HomeTeam: "Carlton", AwayTeam: "Essendon", Competition: "AFLW", Sport: "afl", Season: "2021",
+				StartTime: time.Date(2021, 10, 2, 4, 30, 0, 0, time.UTC)},
+		},
+		{
+			name: "date only",
+			event: `UID:afl-4
+SUMMARY:Carlton vs Essendon
+DTSTART;VALUE=DATE:20211002`,
+			want: Fixture{UID: "afl-4", Name: "Carlton vs Essendon", HomeTeam: "Carlton", AwayTeam: "Essendon", Competition: "AFL", Sport: "afl", Season: "2021",
+				StartTime: time.Date(2021, 10, 2, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			name: "unknown time zone",
+			event: `UID:afl-5
+SUMMARY:Carlton vs Essendon
+DTSTART;TZID=Mars/Olympus:20211002T143000`,
+			wantErr: true,
+		},
+		{
+			name: "no teams",
+			event: `UID:afl-6
+SUMMARY:Grand Final
+DTSTART:20211002T043000Z`,
+			wantErr: true,
+		},
+		{
+			name: "no uid",
+			event: `SUMMARY:Carlton vs Essendon
+DTSTART:20211002T043000Z`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\n" + strings.ReplaceAll(tt.event, "\n", "\r\n") + "\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+
+			fixtures, err := ParseICal(strings.NewReader(file), opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(fixtures) != 1 {
+				t.Fatalf("ParseICal() returned %d fixtures, want 1", len(fixtures))
+			}
+
+			got := fixtures[0]
+			if (got.Err != nil) != tt.wantErr {
+				t.Fatalf("ParseICal() fixture error = %v, wantErr %v", got.Err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			tt.want.Row = 1
+			assertFixture(t, got, &tt.want)
+		})
+	}
+}
+
+func TestSplitICalList(t *testing.T) {
+	tests := []struct {
+		value string
+		want  []string
+	}{
+		{value: "AFL", want: []string{"AFL"}},
+		{value: "AFL,Football", want: []string{"AFL", "Football"}},
+		{value: `Rugby\, Union Cup,International`, want: []string{`Rugby\, Union Cup`, "International"}},
+		{value: `Back\\,Slash`, want: []string{`Back\\`, "Slash"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got := splitICalList(tt.value)
+			if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+				t.Errorf("splitICalList(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func assertFixture(t *testing.T, got, want *Fixture) {
+	t.Helper()
+
+	if !got.StartTime.Equal(want.StartTime) {
+		t.Errorf("start time = %s, want %s", got.StartTime, want.StartTime)
+	}
+
+	got.StartTime, want.StartTime = time.Time{}, time.Time{}
+
+	if *got != *want {
+		t.Errorf("fixture = %+v, want %+v", *got, *want)
+	}
+}
@@ -0,0 +1,142 @@
+package fixtures
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"git.neds.sh/matty/entain/sports/db"
+	"git.neds.sh/matty/entain/sports/proto/sports"
+)
+
+// Skipped is reported for fixtures that couldn't be imported.
+const Skipped db.UpsertAction = "skipped"
+
+// ReportRow describes what the importer did with a single fixture.
+type ReportRow struct {
+	File    string
+	Row     int
+	UID     string
+	Action  db.UpsertAction
+	SportId int64
+	Detail  string
+}
+
+// Importer upserts fixtures as sports.
+type Importer struct {
+	repo db.FixturesRepo
+}
+
+// NewImporter instantiates and returns a new Importer.
+func NewImporter(repo db.FixturesRepo) *Importer {
+	return &Importer{repo: repo}
+}
+
+// Import upserts the given fixtures and reports what happened to each of them.
+//
+// Teams are matched by name or alias so that a fixture always refers to the canonical team name.
+// A fixture that can't be imported is reported as skipped and doesn't stop the rest of the import.
+func (i *Importer) Import(ctx context.Context, file string, fixtures []*Fixture) []*ReportRow {
+	var report []*ReportRow
+
+	for _, fixture := range fixtures {
+		row := &ReportRow{File: file, Row: fixture.Row, UID: fixture.UID}
+		report = append(report, row)
+
+		if fixture.Err != nil {
+			row.Action = Skipped
+			row.Detail = fixture.Err.Error()
+			continue
+		}
+
+		sport, err := i.toSport(ctx, fixture)
+		if err == nil && fixture.Sport != "" && fixture.Competition != "" {
+			err = i.repo.SetCompetitionSport(ctx, fixture.Competition, fixture.Sport)
+		}
+
+		if err == nil {
+			row.Action, err = i.repo.Upsert(ctx, fixture.UID, sport)
+		}
+
+		if err != nil {
+			row.Action = Skipped
+			row.Detail = err.Error()
+			continue
+		}
+
+		row.SportId = sport.Id
+		row.Detail = sport.Name
+	}
+
+	return report
+}
+
+func (i *Importer) toSport(ctx context.Context, fixture *Fixture) (*sports.Sport, error) {
+	homeTeam, err := i.repo.ResolveTeam(ctx, fixture.HomeTeam)
+	if err != nil {
+		return nil, err
+	}
+
+	awayTeam, err := i.repo.ResolveTeam(ctx, fixture.AwayTeam)
+	if err != nil {
+		return nil, err
+	}
+
+	start, err := ptypes.TimestampProto(fixture.StartTime.UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	name := fixture.Name
+	if name == "" {
+		name = homeTeam + " vs " + awayTeam
+	}
+
+	// A fixture without a season is left to the database, which puts it in the season of the year it starts in.
+	sport := &sports.Sport{
+		Name:                name,
+		HomeTeam:            homeTeam,
+		AwayTeam:            awayTeam,
+		Competition:         fixture.Competition,
+		Season:              fixture.Season,
+		AdvertisedStartTime: start,
+		// Betting on a fixture closes when it starts.
+		BettingClosedTime: start,
+	}
+
+	if fixture.Venue != "" {
+		sport.Venue = &sports.Venue{Name: fixture.Venue}
+	}
+
+	return sport, nil
+}
+
+// PrintReport writes the report as a table followed by a summary line.
+func PrintReport(w io.Writer, report []*ReportRow) error {
+	counts := map[db.UpsertAction]int{}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "FILE\tROW\tUID\tACTION\tSPORT ID\tDETAIL")
+
+	for _, row := range report {
+		counts[row.Action]++
+
+		sportId := "-"
+		if row.SportId != 0 {
+			sportId = fmt.Sprint(row.SportId)
+		}
+
+		fmt.Fprintf(tw, "%s\t%d\t%s\t%s\t%s\t%s\n", row.File, row.Row, row.UID, row.Action, sportId, row.Detail)
+	}
+
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	_, err := fmt.Fprintf(w, "\n%d created, %d updated, %d unchanged, %d skipped\n", counts[db.FixtureCreated], counts[db.FixtureUpdated], counts[db.FixtureUnchanged], counts[Skipped])
+
+	return err
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+	_ "time/tzdata"
+
+	"git.neds.sh/matty/entain/sports/db"
+	"git.neds.sh/matty/entain/sports/fixtures"
+	"git.neds.sh/matty/entain/sports/proto/sports"
+)
+
+// importFixtures implements the import-fixtures sub command. It upserts the fixtures of the given iCalendar and CSV files as sports.
+//
+//	sports import-fixtures [-competition name] [-sport kind] [-season season] [-points win,draw,loss] [-timezone zone] [-aliases aliases.csv] file...
+//
+// The aliases file is a CSV file with alias,team rows that's used to match the alternative team names used by fixture publishers.
+func importFixtures(args []string) error {
+	fs := flag.NewFlagSet("import-fixtures", flag.ExitOnError)
+	competition := fs.String("competition", "", "Competition of the fixtures that don't specify one")
+	sport := fs.String("sport", "", "Kind of sport (soccer, afl etc.) played in the competitions of the fixtures that don't specify one")
+	season := fs.String("season", "", "Season of the fixtures that don't specify one. Defaults to the year of their start time")
+	points := fs.String("points", "", "Ladder points for a win, a draw and a loss in the imported competitions, e.g. 3,1,0")
+	timezone := fs.String("timezone", "UTC", "IANA time zone of the start times that don't specify one")
+	aliases := fs.String("aliases", "", "CSV file with alias,team rows used to match team names")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s import-fixtures [flags] file...\n", filepath.Base(os.Args[0]))
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return errors.New("no fixture files given")
+	}
+
+	loc, err := time.LoadLocation(*timezone)
+	if err != nil {
+		return err
+	}
+
+	var rules *sports.PointsRules
+	if *points != "" {
+		if rules, err = parsePointsRules(*points); err != nil {
+			return err
+		}
+	}
+
+	sportsDB, err := sql.Open("sqlite3", dataSourceName)
+	if err != nil {
+		return err
+	}
+	defer sportsDB.Close()
+
+	sportsRepo := db.NewSportsRepo(sportsDB)
+	if err := sportsRepo.Init(); err != nil {
+		return err
+	}
+
+	// Updating a fixture that has a result moves it in the standings as well.
+	resultsRepo := db.NewResultsRepo(sportsDB)
+	if err := resultsRepo.Init(); err != nil {
+		return err
+	}
+
+	standingsRepo := db.NewStandingsRepo(sportsDB)
+	if err := standingsRepo.Init(); err != nil {
+		return err
+	}
+
+	fixturesRepo := db.NewFixturesRepo(sportsDB)
+	if err := fixturesRepo.Init(); err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	if *aliases != "" {
+		if err := loadAliases(ctx, fixturesRepo, *aliases); err != nil {
+			return err
+		}
+	}
+
+	var report []*fixtures.ReportRow
+
+	importer := fixtures.NewImporter(fixturesRepo)
+	competitions := map[string]bool{}
+
+	for _, file := range fs.Args() {
+		parsed, err := fixtures.ParseFile(file, fixtures.Options{Competition: *competition, Sport: *sport, Season: *season, Location: loc})
+		if err != nil {
+			return err
+		}
+
+		for _, fixture := range parsed {
+			if fixture.Err == nil && fixture.Competition != "" {
+				competitions[fixture.Competition] = true
+			}
+		}
+
+		report = append(report, importer.Import(ctx, file, parsed)...)
+	}
+
+	if rules != nil {
+		for competition := range competitions {
+			if err := fixturesRepo.SetCompetitionPoints(ctx, competition, rules); err != nil {
+				return err
+			}
+		}
+	}
+
+	return fixtures.PrintReport(os.Stdout, report)
+}
+
+// parsePointsRules reads points rules given as win,draw,loss.
+func parsePointsRules(value string) (*sports.PointsRules, error) {
+	var rules sports.PointsRules
+
+	if _, err := fmt.Sscanf(value, "%d,%d,%d", &rules.Win, &rules.Draw, &rules.Loss); err != nil {
+		return nil, fmt.Errorf("invalid points %q, expected win,draw,loss e.g. 3,1,0", value)
+	}
+
+	return &rules, nil
+}
+
+// loadAliases registers the alias,team rows of the given CSV file.
+func loadAliases(ctx context.Context, repo db.FixturesRepo, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return err
+	}
+
+	for i, record := range records {
+		if len(record) != 2 {
+			return fmt.Errorf("%s:%d: expected alias,team", path, i+1)
+		}
+
+		if err := repo.AddAlias(ctx, record[0], record[1]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
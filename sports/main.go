@@ -1,54 +1,166 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"flag"
 	"net"
+	"time"
 
+	"git.neds.sh/matty/entain/common/logging"
+	"git.neds.sh/matty/entain/common/metrics"
+	"git.neds.sh/matty/entain/common/rpcerror"
+	"git.neds.sh/matty/entain/common/tlsconfig"
+	"git.neds.sh/matty/entain/common/tracing"
 	"git.neds.sh/matty/entain/sports/db"
 	"git.neds.sh/matty/entain/sports/proto/sports"
 	"git.neds.sh/matty/entain/sports/service"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
+// dataSourceName is the database of the service. _txlock=immediate makes every transaction of the service take the
+// write lock as it begins, rather than at its first write. A transaction reading before writing, e.g. the next revision
+// of a result, would otherwise fail with SQLITE_BUSY when another one writes in between, and lose the revision. The
+// transactions that only read wait for the writes as well, which is fine for the few the service runs.
+const dataSourceName = "././db/events.db?_txlock=immediate"
+
 var (
-	grpcEndpoint = flag.String("grpc-sports-endpoint", "localhost:9001", "gRPC server endpoint")
+	grpcEndpoint        = flag.String("grpc-sports-endpoint", ":9001", "gRPC server endpoint, e.g. :9011 to run another instance on the same host")
+	healthCheckInterval = flag.Duration("health-check-interval", 5*time.Second, "How often the database is checked for the gRPC health service")
+	metricsEndpoint     = flag.String("metrics-endpoint", "localhost:9101", "Endpoint the Prometheus metrics are served on at /metrics. Metrics aren't served when it's empty")
+	otlpEndpoint        = flag.String("otlp-endpoint", "", "Endpoint of the OTLP collector the traces are exported to over gRPC, e.g. localhost:4317")
+	traceOutput         = flag.String("trace-output", "none", "File the traces are written to when there's no OTLP collector, or none to drop them")
+	tlsCert             = flag.String("tls-cert", "", "PEM certificate chain of the gRPC server, reloaded when it changes. The server doesn't use TLS when it's not set")
+	tlsKey              = flag.String("tls-key", "", "PEM private key of -tls-cert, reloaded when it changes")
+	tlsClientCA         = flag.String("tls-client-ca", "", "PEM CA certificates the client certificates are verified against, which are then required (mutual TLS)")
+	logLevel            = flag.String("log-level", "info", "Level of the logs: debug, info, warn or error")
+	logFormat           = flag.String("log-format", "json", "Format of the logs: json or text")
 )
 
 func main() {
 	flag.Parse()
 
+	if err := logging.Configure(*logLevel, *logFormat); err != nil {
+		log.Fatalf("failed configuring logs: %s", err)
+	}
+
+	if flag.Arg(0) == "import-fixtures" {
+		if err := importFixtures(flag.Args()[1:]); err != nil {
+			log.Fatalf("failed importing fixtures: %s", err)
+		}
+
+		return
+	}
+
 	if err := run(); err != nil {
 		log.Fatalf("failed running grpc server: %s", err)
 	}
 }
 
 func run() error {
-	conn, err := net.Listen("tcp", ":9001")
+	conn, err := net.Listen("tcp", *grpcEndpoint)
 	if err != nil {
 		return err
 	}
 
-	sportsDB, err := sql.Open("sqlite3", "././db/events.db")
+	sportsDB, err := sql.Open("sqlite3", dataSourceName)
 	if err != nil {
 		return err
 	}
 
-	sportsRepo := db.NewSportsRepo(sportsDB)
+	sportsRepo := db.NewInstrumentedSportsRepo(db.NewSportsRepo(sportsDB))
 	if err := sportsRepo.Init(); err != nil {
 		return err
 	}
 
-	grpcServer := grpc.NewServer()
+	resultsRepo := db.NewResultsRepo(sportsDB)
+	if err := resultsRepo.Init(); err != nil {
+		return err
+	}
+
+	// The head to head resolves the team names with the teams and aliases of the imported fixtures.
+	if err := db.NewFixturesRepo(sportsDB).Init(); err != nil {
+		return err
+	}
+
+	incidentsRepo := db.NewIncidentsRepo(sportsDB)
+	if err := incidentsRepo.Init(); err != nil {
+		return err
+	}
+
+	standingsRepo := db.NewStandingsRepo(sportsDB)
+	if err := standingsRepo.Init(); err != nil {
+		return err
+	}
+
+	marketsRepo := db.NewMarketsRepo(sportsDB)
+	if err := marketsRepo.Init(); err != nil {
+		return err
+	}
+
+	outrightsRepo := db.NewOutrightsRepo(sportsDB)
+	if err := outrightsRepo.Init(); err != nil {
+		return err
+	}
+
+	shutdownTracing, err := tracing.Init(context.Background(), "sports", *otlpEndpoint, *traceOutput)
+	if err != nil {
+		return err
+	}
+	defer shutdownTracing(context.Background())
+
+	// The tracing interceptors continue the traces of the gateway, whose context comes in the gRPC metadata. The error
+	// ones are last so that the errors are logged and counted with the status the clients get.
+	serverOptions := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(otelgrpc.UnaryServerInterceptor(), logging.UnaryServerInterceptor, metrics.UnaryServerInterceptor, rpcerror.UnaryServerInterceptor),
+		grpc.ChainStreamInterceptor(otelgrpc.StreamServerInterceptor(), logging.StreamServerInterceptor, metrics.StreamServerInterceptor, rpcerror.StreamServerInterceptor),
+	}
+
+	// The server uses TLS when it has a certificate, and mutual TLS when it has a client CA as well.
+	if *tlsCert != "" {
+		config, err := tlsconfig.Server(tlsconfig.Files{Cert: *tlsCert, Key: *tlsKey, CA: *tlsClientCA})
+		if err != nil {
+			return err
+		}
+
+		serverOptions = append(serverOptions, grpc.Creds(credentials.NewTLS(config)))
+	}
+
+	grpcServer := grpc.NewServer(serverOptions...)
 
 	sports.RegisterSportsServer(
 		grpcServer,
 		service.NewSportsService(
 			sportsRepo,
+			resultsRepo,
+			incidentsRepo,
+			standingsRepo,
+			marketsRepo,
+			outrightsRepo,
 		),
 	)
 
+	// The health service is NOT_SERVING while the database can't be queried.
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	healthChecker := service.NewHealthChecker(healthServer, func(ctx context.Context) error {
+		return db.Ping(ctx, sportsDB)
+	}, "sports.Sports")
+	go healthChecker.Run(ctx, *healthCheckInterval)
+
+	if *metricsEndpoint != "" {
+		metrics.Serve(*metricsEndpoint)
+	}
+
 	log.Infof("gRPC server listening on: %s", *grpcEndpoint)
 
 	if err := grpcServer.Serve(conn); err != nil {
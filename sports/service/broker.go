@@ -0,0 +1,69 @@
+package service
+
+import (
+	"sync"
+
+	"git.neds.sh/matty/entain/sports/proto/sports"
+)
+
+// subscriberBufferSize is the number of updates that can be queued for a subscriber before it's considered too slow.
+const subscriberBufferSize = 64
+
+// broker fans out the live updates of sports events to the streaming subscribers within this process.
+type broker struct {
+	mu          sync.Mutex
+	subscribers map[int64]map[chan *sports.EventUpdate]struct{}
+}
+
+func newBroker() *broker {
+	return &broker{subscribers: map[int64]map[chan *sports.EventUpdate]struct{}{}}
+}
+
+// subscribe returns a channel receiving the updates of the given sports event and a function to stop the subscription.
+// The channel is closed when the subscription stops, including when the subscriber falls too far behind.
+func (b *broker) subscribe(eventId int64) (<-chan *sports.EventUpdate, func()) {
+	ch := make(chan *sports.EventUpdate, subscriberBufferSize)
+
+	b.mu.Lock()
+	if b.subscribers[eventId] == nil {
+		b.subscribers[eventId] = map[chan *sports.EventUpdate]struct{}{}
+	}
+	b.subscribers[eventId][ch] = struct{}{}
+	b.mu.Unlock()
+
+	return ch, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		b.remove(eventId, ch)
+	}
+}
+
+// publish sends the update to all the subscribers of its sports event without blocking.
+// Subscribers that can't keep up are dropped, they can resume from the last sequence they received.
+func (b *broker) publish(update *sports.EventUpdate) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subscribers[update.EventId] {
+		select {
+		case ch <- update:
+		default:
+			b.remove(update.EventId, ch)
+		}
+	}
+}
+
+// remove must be called with the lock held.
+func (b *broker) remove(eventId int64, ch chan *sports.EventUpdate) {
+	if _, ok := b.subscribers[eventId][ch]; !ok {
+		return
+	}
+
+	delete(b.subscribers[eventId], ch)
+	close(ch)
+
+	if len(b.subscribers[eventId]) == 0 {
+		delete(b.subscribers, eventId)
+	}
+}
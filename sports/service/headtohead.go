@@ -0,0 +1,103 @@
+package service
+
+import (
+	"strings"
+
+	"git.neds.sh/matty/entain/common/rpcerror"
+	"git.neds.sh/matty/entain/sports/proto/sports"
+	"golang.org/x/net/context"
+)
+
+const (
+	// defaultHeadToHeadLimit is the number of meetings returned when the request doesn't specify a limit.
+	defaultHeadToHeadLimit = 10
+	// maxHeadToHeadLimit is the maximum number of meetings that can be requested.
+	maxHeadToHeadLimit = 100
+)
+
+// Get the recent meetings between two teams with the head to head record of team_a against team_b.
+//
+// The record covers all the meetings with a final result, not only the ones returned.
+func (s *sportingService) GetHeadToHead(ctx context.Context, in *sports.GetHeadToHeadRequest) (*sports.GetHeadToHeadResponse, error) {
+	var v rpcerror.Violations
+
+	if strings.TrimSpace(in.TeamA) == "" {
+		v.Add("team_a", "team_a is required")
+	}
+
+	if strings.TrimSpace(in.TeamB) == "" {
+		v.Add("team_b", "team_b is required")
+	}
+
+	if in.Limit < 0 || in.Limit > maxHeadToHeadLimit {
+		v.Add("limit", "limit must be between 0 and %d", maxHeadToHeadLimit)
+	}
+
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	limit := in.Limit
+	if limit == 0 {
+		limit = defaultHeadToHeadLimit
+	}
+
+	teamA, teamB, meetings, err := s.resultsRepo.ListHeadToHead(ctx, in.TeamA, in.TeamB)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &sports.GetHeadToHeadResponse{
+		TeamA:   teamA,
+		TeamB:   teamB,
+		Summary: &sports.HeadToHeadRecord{},
+	}
+
+	if in.IncludeSplits {
+		response.Home = &sports.HeadToHeadRecord{}
+		response.Away = &sports.HeadToHeadRecord{}
+	}
+
+	for _, meeting := range meetings {
+		homeTeamIsA := strings.EqualFold(meeting.HomeTeam, teamA)
+
+		addToRecord(response.Summary, meeting, homeTeamIsA)
+
+		if in.IncludeSplits {
+			if homeTeamIsA {
+				addToRecord(response.Home, meeting, true)
+			} else {
+				addToRecord(response.Away, meeting, false)
+			}
+		}
+	}
+
+	if int64(len(meetings)) > limit {
+		meetings = meetings[:limit]
+	}
+
+	response.Meetings = meetings
+
+	return response, nil
+}
+
+// addToRecord adds a meeting to the record of team_a.
+func addToRecord(record *sports.HeadToHeadRecord, meeting *sports.HeadToHeadMeeting, homeTeamIsA bool) {
+	scored, conceded := meeting.HomeScore, meeting.AwayScore
+	if !homeTeamIsA {
+		scored, conceded = meeting.AwayScore, meeting.HomeScore
+	}
+
+	record.Played++
+	record.PointsFor += scored
+	record.PointsAgainst += conceded
+
+	switch {
+	case scored > conceded:
+		record.Wins++
+	case scored < conceded:
+		record.Losses++
+	default:
+		record.Draws++
+	}
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// HealthChecker keeps the gRPC health service up to date with the state of a dependency, e.g. the database.
+type HealthChecker struct {
+	server   *health.Server
+	check    func(ctx context.Context) error
+	services []string
+	checked  bool
+	serving  bool
+}
+
+// NewHealthChecker instantiates and returns a new HealthChecker. The given services, along with the overall ("") health,
+// are NOT_SERVING until the check first passes and whenever it fails.
+func NewHealthChecker(server *health.Server, check func(ctx context.Context) error, services ...string) *HealthChecker {
+	h := &HealthChecker{server: server, check: check, services: append([]string{""}, services...)}
+
+	for _, service := range h.services {
+		server.SetServingStatus(service, healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+
+	return h
+}
+
+// Run runs the check straight away and then at every interval until the context is done.
+func (h *HealthChecker) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		h.update(ctx, interval)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (h *HealthChecker) update(ctx context.Context, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	status := healthpb.HealthCheckResponse_SERVING
+
+	err := h.check(ctx)
+	if err != nil {
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+
+	// Only the failures and the recoveries are logged, the check runs every few seconds.
+	if serving := err == nil; !h.checked || serving != h.serving {
+		if !serving {
+			log.Errorf("health check failed, not serving: %s", err)
+		} else if h.checked {
+			log.Info("health check passed again, serving")
+		}
+
+		h.checked, h.serving = true, serving
+	}
+
+	for _, service := range h.services {
+		h.server.SetServingStatus(service, status)
+	}
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"strings"
+
+	"git.neds.sh/matty/entain/common/rpcerror"
+	"git.neds.sh/matty/entain/sports/proto/sports"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Add an incident to the timeline of a sports event and push it to the subscribers of the event
+func (s *sportingService) AddIncident(ctx context.Context, in *sports.AddIncidentRequest) (*sports.AddIncidentResponse, error) {
+	var v rpcerror.Violations
+
+	if in.Period < 0 {
+		v.Add("period", "period can't be negative, got %d", in.Period)
+	}
+
+	if in.Minute < 0 {
+		v.Add("minute", "minute can't be negative, got %d", in.Minute)
+	}
+
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	sport, err := s.getSport(ctx, "event_id", in.EventId)
+	if err != nil {
+		return nil, err
+	}
+
+	incidentType := strings.ToUpper(strings.TrimSpace(in.Type))
+
+	types, err := s.incidentsRepo.ListTypes(ctx, in.EventId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !contains(types, incidentType) {
+		return nil, rpcerror.InvalidArgument("type", "unknown incident type %q, expected one of %s", in.Type, strings.Join(types, ", "))
+	}
+
+	incident := &sports.Incident{
+		EventId:     in.EventId,
+		Type:        incidentType,
+		Period:      in.Period,
+		Minute:      in.Minute,
+		Side:        in.Side,
+		Player:      in.Player,
+		Description: in.Description,
+	}
+
+	switch in.Side {
+	case sports.Incident_HOME:
+		incident.Team = sport.HomeTeam
+	case sports.Incident_AWAY:
+		incident.Team = sport.AwayTeam
+	}
+
+	incident, err = s.incidentsRepo.Add(ctx, incident)
+	if err != nil {
+		return nil, err
+	}
+
+	s.broker.publish(&sports.EventUpdate{EventId: incident.EventId, Update: &sports.EventUpdate_Incident{Incident: incident}})
+
+	return &sports.AddIncidentResponse{Incident: incident}, nil
+}
+
+// Get the incidents of a sports event added after the given sequence
+func (s *sportingService) ListIncidents(ctx context.Context, in *sports.ListIncidentsRequest) (*sports.ListIncidentsResponse, error) {
+	if in.SinceSequence < 0 {
+		return nil, rpcerror.InvalidArgument("since_sequence", "since_sequence can't be negative, got %d", in.SinceSequence)
+	}
+
+	if _, err := s.getSport(ctx, "event_id", in.EventId); err != nil {
+		return nil, err
+	}
+
+	incidents, err := s.incidentsRepo.List(ctx, in.EventId, in.SinceSequence)
+	if err != nil {
+		return nil, err
+	}
+
+	lastSequence := in.SinceSequence
+	if len(incidents) > 0 {
+		lastSequence = incidents[len(incidents)-1].Sequence
+	}
+
+	return &sports.ListIncidentsResponse{Incidents: incidents, LastSequence: lastSequence}, nil
+}
+
+// Stream the live updates of a sports event.
+//
+// The incidents added after since_sequence are sent first so that a client can resume a stream without missing anything.
+// The subscription starts before reading them so that no incident can fall in between the two.
+func (s *sportingService) WatchEvent(in *sports.WatchEventRequest, stream sports.Sports_WatchEventServer) error {
+	if in.SinceSequence < 0 {
+		return rpcerror.InvalidArgument("since_sequence", "since_sequence can't be negative, got %d", in.SinceSequence)
+	}
+
+	if _, err := s.getSport(stream.Context(), "event_id", in.EventId); err != nil {
+		return err
+	}
+
+	updates, unsubscribe := s.broker.subscribe(in.EventId)
+	defer unsubscribe()
+
+	incidents, err := s.incidentsRepo.List(stream.Context(), in.EventId, in.SinceSequence)
+	if err != nil {
+		return err
+	}
+
+	lastSequence := in.SinceSequence
+
+	for _, incident := range incidents {
+		if err := stream.Send(&sports.EventUpdate{EventId: in.EventId, Update: &sports.EventUpdate_Incident{Incident: incident}}); err != nil {
+			return err
+		}
+
+		lastSequence = incident.Sequence
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case update, ok := <-updates:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "the stream fell too far behind, resume from the last sequence received")
+			}
+
+			// Skip the incidents that were already sent while catching up.
+			if incident := update.GetIncident(); incident != nil && incident.Sequence <= lastSequence {
+				continue
+			}
+
+			if err := stream.Send(update); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
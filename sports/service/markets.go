@@ -0,0 +1,209 @@
+package service
+
+import (
+	"strings"
+
+	"git.neds.sh/matty/entain/common/logging"
+	"git.neds.sh/matty/entain/common/rpcerror"
+	"git.neds.sh/matty/entain/sports/proto/sports"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
+)
+
+// Get the markets of a sports event
+func (s *sportingService) ListMarkets(ctx context.Context, in *sports.ListMarketsRequest) (*sports.ListMarketsResponse, error) {
+	if _, err := s.getSport(ctx, "event_id", in.EventId); err != nil {
+		return nil, err
+	}
+
+	markets, err := s.marketsRepo.List(ctx, in.EventId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sports.ListMarketsResponse{Markets: markets}, nil
+}
+
+// Suspend all the markets of a sports event and push the change to the subscribers of the event
+func (s *sportingService) SuspendEvent(ctx context.Context, in *sports.SuspendEventRequest) (*sports.SuspendEventResponse, error) {
+	reason, actor, err := validateSuspension(ctx, in.Reason, in.Actor, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getSport(ctx, "event_id", in.EventId); err != nil {
+		return nil, err
+	}
+
+	change, err := s.marketsRepo.Suspend(ctx, in.EventId, 0, reason, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishSuspension(in.EventId, change)
+
+	sport, err := s.sportsRepo.GetSportById(ctx, in.EventId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sports.SuspendEventResponse{Sport: sport}, nil
+}
+
+// Reopen the markets of a suspended sports event and push the change to the subscribers of the event
+func (s *sportingService) ResumeEvent(ctx context.Context, in *sports.ResumeEventRequest) (*sports.ResumeEventResponse, error) {
+	_, actor, err := validateSuspension(ctx, "", in.Actor, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getSport(ctx, "event_id", in.EventId); err != nil {
+		return nil, err
+	}
+
+	change, err := s.marketsRepo.Resume(ctx, in.EventId, 0, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishSuspension(in.EventId, change)
+
+	sport, err := s.sportsRepo.GetSportById(ctx, in.EventId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sports.ResumeEventResponse{Sport: sport}, nil
+}
+
+// Suspend a market of a sports event and push the change to the subscribers of the event
+func (s *sportingService) SuspendMarket(ctx context.Context, in *sports.SuspendMarketRequest) (*sports.SuspendMarketResponse, error) {
+	reason, actor, err := validateSuspension(ctx, in.Reason, in.Actor, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getMarket(ctx, in.EventId, in.MarketId); err != nil {
+		return nil, err
+	}
+
+	change, err := s.marketsRepo.Suspend(ctx, in.EventId, in.MarketId, reason, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishSuspension(in.EventId, change)
+
+	market, err := s.marketsRepo.Get(ctx, in.EventId, in.MarketId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sports.SuspendMarketResponse{Market: market}, nil
+}
+
+// Reopen a suspended market of a sports event and push the change to the subscribers of the event
+func (s *sportingService) ResumeMarket(ctx context.Context, in *sports.ResumeMarketRequest) (*sports.ResumeMarketResponse, error) {
+	_, actor, err := validateSuspension(ctx, "", in.Actor, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getMarket(ctx, in.EventId, in.MarketId); err != nil {
+		return nil, err
+	}
+
+	change, err := s.marketsRepo.Resume(ctx, in.EventId, in.MarketId, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishSuspension(in.EventId, change)
+
+	market, err := s.marketsRepo.Get(ctx, in.EventId, in.MarketId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sports.ResumeMarketResponse{Market: market}, nil
+}
+
+// getSport returns the sports event with the given id, or an InvalidArgument error naming the id field of the request or
+// a NotFound error.
+func (s *sportingService) getSport(ctx context.Context, field string, eventId int64) (*sports.Sport, error) {
+	if eventId <= 0 {
+		return nil, rpcerror.InvalidArgument(field, "%s must be positive, got %d", field, eventId)
+	}
+
+	sport, err := s.sportsRepo.GetSportById(ctx, eventId)
+	if err != nil {
+		return nil, err
+	}
+
+	if sport == nil {
+		return nil, rpcerror.NotFound("sports.Sport", eventId, "sport %d not found", eventId)
+	}
+
+	return sport, nil
+}
+
+// getMarket returns the market of a sports event with the given id, or an InvalidArgument or a NotFound error.
+func (s *sportingService) getMarket(ctx context.Context, eventId int64, marketId int64) (*sports.Market, error) {
+	var v rpcerror.Violations
+
+	if eventId <= 0 {
+		v.Add("event_id", "event_id must be positive, got %d", eventId)
+	}
+
+	if marketId <= 0 {
+		v.Add("market_id", "market_id must be positive, got %d", marketId)
+	}
+
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	market, err := s.marketsRepo.Get(ctx, eventId, marketId)
+	if err != nil {
+		return nil, err
+	}
+
+	if market == nil {
+		return nil, rpcerror.NotFound("sports.Market", marketId, "market %d of sport %d not found", marketId, eventId)
+	}
+
+	return market, nil
+}
+
+// publishSuspension pushes a suspension change to the subscribers of the event. Nothing is pushed when nothing changed.
+func (s *sportingService) publishSuspension(eventId int64, change *sports.SuspensionChange) {
+	if change == nil {
+		return
+	}
+
+	s.broker.publish(&sports.EventUpdate{EventId: eventId, Update: &sports.EventUpdate_Suspension{Suspension: change}})
+}
+
+// validateSuspension trims the reason and the actor. The actor is always required and the reason is required when suspending.
+//
+// The subject of the bearer token forwarded by the gateway is the actor when there's one, so that a client can't record
+// a change under someone else's name. The actor of the request is only used when auth is off.
+func validateSuspension(ctx context.Context, reason string, actor string, suspending bool) (string, string, error) {
+	reason, actor = strings.TrimSpace(reason), strings.TrimSpace(actor)
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if subjects := md.Get(logging.SubjectMetadataKey); len(subjects) > 0 && subjects[0] != "" {
+			actor = subjects[0]
+		}
+	}
+
+	if actor == "" {
+		return "", "", rpcerror.InvalidArgument("actor", "actor is required")
+	}
+
+	if suspending && reason == "" {
+		return "", "", rpcerror.InvalidArgument("reason", "reason is required")
+	}
+
+	return reason, actor, nil
+}
@@ -1,6 +1,10 @@
 package service
 
 import (
+	"fmt"
+	"strings"
+
+	"git.neds.sh/matty/entain/common/rpcerror"
 	"git.neds.sh/matty/entain/sports/db"
 	"git.neds.sh/matty/entain/sports/proto/sports"
 	"golang.org/x/net/context"
@@ -12,21 +16,76 @@ type Sports interface {
 
 	// Get sport details by id
 	GetSportById(ctx context.Context, in *sports.GetSportRequest) (*sports.GetSportResponse, error)
+
+	// GetEventResult will return the latest result of a sports event and its revision history.
+	GetEventResult(ctx context.Context, in *sports.GetEventResultRequest) (*sports.GetEventResultResponse, error)
+
+	// RecordEventResult will record a new revision of a sports event result.
+	RecordEventResult(ctx context.Context, in *sports.RecordEventResultRequest) (*sports.RecordEventResultResponse, error)
+
+	// AddIncident will add an incident to the timeline of a sports event.
+	AddIncident(ctx context.Context, in *sports.AddIncidentRequest) (*sports.AddIncidentResponse, error)
+
+	// ListIncidents will return the timeline of a sports event.
+	ListIncidents(ctx context.Context, in *sports.ListIncidentsRequest) (*sports.ListIncidentsResponse, error)
+
+	// WatchEvent will stream the live updates of a sports event.
+	WatchEvent(in *sports.WatchEventRequest, stream sports.Sports_WatchEventServer) error
+
+	// GetStandings will return the ladder of a competition season.
+	GetStandings(ctx context.Context, in *sports.GetStandingsRequest) (*sports.GetStandingsResponse, error)
+
+	// GetHeadToHead will return the recent meetings between two teams and their head to head record.
+	GetHeadToHead(ctx context.Context, in *sports.GetHeadToHeadRequest) (*sports.GetHeadToHeadResponse, error)
+
+	// ListMarkets will return the markets of a sports event.
+	ListMarkets(ctx context.Context, in *sports.ListMarketsRequest) (*sports.ListMarketsResponse, error)
+
+	// SuspendEvent will suspend all the markets of a sports event.
+	SuspendEvent(ctx context.Context, in *sports.SuspendEventRequest) (*sports.SuspendEventResponse, error)
+
+	// ResumeEvent will reopen the markets of a suspended sports event.
+	ResumeEvent(ctx context.Context, in *sports.ResumeEventRequest) (*sports.ResumeEventResponse, error)
+
+	// SuspendMarket will suspend a market of a sports event.
+	SuspendMarket(ctx context.Context, in *sports.SuspendMarketRequest) (*sports.SuspendMarketResponse, error)
+
+	// ResumeMarket will reopen a suspended market of a sports event.
+	ResumeMarket(ctx context.Context, in *sports.ResumeMarketRequest) (*sports.ResumeMarketResponse, error)
+
+	// ListOutrights will return the outright markets of competitions.
+	ListOutrights(ctx context.Context, in *sports.ListOutrightsRequest) (*sports.ListOutrightsResponse, error)
+
+	// GetOutright will return an outright market by id.
+	GetOutright(ctx context.Context, in *sports.GetOutrightRequest) (*sports.GetOutrightResponse, error)
+
+	// SettleOutright will settle an outright market with its winning selection.
+	SettleOutright(ctx context.Context, in *sports.SettleOutrightRequest) (*sports.SettleOutrightResponse, error)
 }
 
 // sportingService implements the Sports interface.
 type sportingService struct {
-	sportsRepo db.SportsRepo
+	sportsRepo    db.SportsRepo
+	resultsRepo   db.ResultsRepo
+	incidentsRepo db.IncidentsRepo
+	standingsRepo db.StandingsRepo
+	marketsRepo   db.MarketsRepo
+	outrightsRepo db.OutrightsRepo
+	broker        *broker
 }
 
 // NewSportsService instantiates and returns a new sportingService.
-func NewSportsService(sportsRepo db.SportsRepo) Sports {
-	return &sportingService{sportsRepo}
+func NewSportsService(sportsRepo db.SportsRepo, resultsRepo db.ResultsRepo, incidentsRepo db.IncidentsRepo, standingsRepo db.StandingsRepo, marketsRepo db.MarketsRepo, outrightsRepo db.OutrightsRepo) Sports {
+	return &sportingService{sportsRepo, resultsRepo, incidentsRepo, standingsRepo, marketsRepo, outrightsRepo, newBroker()}
 }
 
 // Get a list of sports with filter and order by clauses
 func (s *sportingService) ListEvents(ctx context.Context, in *sports.ListEventsRequest) (*sports.ListEventsResponse, error) {
-	sportEvents, err := s.sportsRepo.List(in.Filter, in.OrderBy)
+	if err := validateListEvents(in); err != nil {
+		return nil, err
+	}
+
+	sportEvents, err := s.sportsRepo.List(ctx, in.Filter, in.OrderBy)
 	if err != nil {
 		return nil, err
 	}
@@ -36,10 +95,142 @@ func (s *sportingService) ListEvents(ctx context.Context, in *sports.ListEventsR
 
 // Get sport details by id
 func (s *sportingService) GetSportById(ctx context.Context, in *sports.GetSportRequest) (*sports.GetSportResponse, error) {
-	sport, err := s.sportsRepo.GetSportById(in.Id)
+	sport, err := s.getSport(ctx, "id", in.Id)
 	if err != nil {
 		return nil, err
 	}
 
 	return &sports.GetSportResponse{Sport: sport}, nil
 }
+
+// Get the latest result of a sports event with its revision history
+func (s *sportingService) GetEventResult(ctx context.Context, in *sports.GetEventResultRequest) (*sports.GetEventResultResponse, error) {
+	if _, err := s.getSport(ctx, "id", in.Id); err != nil {
+		return nil, err
+	}
+
+	result, err := s.resultsRepo.Get(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.resultsRepo.ListRevisions(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sports.GetEventResultResponse{Result: result, Revisions: revisions}, nil
+}
+
+// Record a new revision of a sports event result.
+//
+// The outcome and the winner are derived from the scores once the result is final. Recording a final result will move the sport status to FINISHED.
+func (s *sportingService) RecordEventResult(ctx context.Context, in *sports.RecordEventResultRequest) (*sports.RecordEventResultResponse, error) {
+	var v rpcerror.Violations
+
+	if in.HomeScore < 0 {
+		v.Add("home_score", "scores can't be negative, got %d", in.HomeScore)
+	}
+
+	if in.AwayScore < 0 {
+		v.Add("away_score", "scores can't be negative, got %d", in.AwayScore)
+	}
+
+	for i, periodScore := range in.PeriodScores {
+		if periodScore.Period < 1 {
+			v.Add(fmt.Sprintf("period_scores[%d].period", i), "periods start at 1, got %d", periodScore.Period)
+		}
+
+		if periodScore.HomeScore < 0 || periodScore.AwayScore < 0 {
+			v.Add(fmt.Sprintf("period_scores[%d]", i), "invalid score for period %d, scores can't be negative", periodScore.Period)
+		}
+	}
+
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	sport, err := s.getSport(ctx, "id", in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &sports.EventResult{
+		EventId:      in.Id,
+		HomeScore:    in.HomeScore,
+		AwayScore:    in.AwayScore,
+		PeriodScores: in.PeriodScores,
+		Final:        in.Final,
+		Reason:       in.Reason,
+	}
+
+	if result.Final {
+		switch {
+		case result.HomeScore > result.AwayScore:
+			result.Outcome = sports.EventResult_HOME
+			result.Winner = sport.HomeTeam
+		case result.HomeScore < result.AwayScore:
+			result.Outcome = sports.EventResult_AWAY
+			result.Winner = sport.AwayTeam
+		default:
+			result.Outcome = sports.EventResult_DRAW
+		}
+	}
+
+	result, err = s.resultsRepo.Record(ctx, result)
+	if err != nil {
+		return nil, err
+	}
+
+	s.broker.publish(&sports.EventUpdate{EventId: result.EventId, Update: &sports.EventUpdate_Result{Result: result}})
+
+	return &sports.RecordEventResultResponse{Result: result}, nil
+}
+
+// validateListEvents returns an InvalidArgument error listing the invalid filters and order by fields of the request.
+func validateListEvents(in *sports.ListEventsRequest) error {
+	var v rpcerror.Violations
+
+	if filter := in.Filter; filter != nil {
+		for i, id := range filter.MeetingIds {
+			if id <= 0 {
+				v.Add(fmt.Sprintf("filter.meeting_ids[%d]", i), "meeting ids must be positive, got %d", id)
+			}
+		}
+
+		for i, id := range filter.VenueIds {
+			if id <= 0 {
+				v.Add(fmt.Sprintf("filter.venue_ids[%d]", i), "venue ids must be positive, got %d", id)
+			}
+		}
+
+		if filter.Country != "" && !isCountryCode(strings.TrimSpace(filter.Country)) {
+			v.Add("filter.country", "country must be an ISO 3166-1 alpha-2 code, e.g. AU, got %q", filter.Country)
+		}
+	}
+
+	if in.OrderBy != nil {
+		for i, field := range in.OrderBy.OrderByFields {
+			if !contains(db.SportOrderByFields, field.Field) {
+				v.Add(fmt.Sprintf("order_by.order_by_fields[%d].field", i), "sports events can't be ordered by %q, use one of %s", field.Field, strings.Join(db.SportOrderByFields, ", "))
+			}
+		}
+	}
+
+	return v.Err()
+}
+
+// isCountryCode reports whether the value looks like an ISO 3166-1 alpha-2 code, in any case.
+func isCountryCode(value string) bool {
+	if len(value) != 2 {
+		return false
+	}
+
+	for _, c := range strings.ToUpper(value) {
+		if c < 'A' || c > 'Z' {
+			return false
+		}
+	}
+
+	return true
+}
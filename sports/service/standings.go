@@ -0,0 +1,87 @@
+package service
+
+import (
+	"sort"
+
+	"git.neds.sh/matty/entain/common/rpcerror"
+	"git.neds.sh/matty/entain/sports/proto/sports"
+	"golang.org/x/net/context"
+)
+
+// Get the ladder of a competition season.
+//
+// The repository keeps the games played, won, drawn and lost up to date as results are recorded.
+// Only the ladder points, the percentage and the positions are worked out here, using the current points rules of the competition.
+func (s *sportingService) GetStandings(ctx context.Context, in *sports.GetStandingsRequest) (*sports.GetStandingsResponse, error) {
+	if in.CompetitionId <= 0 {
+		return nil, rpcerror.InvalidArgument("competition_id", "competition_id must be positive, got %d", in.CompetitionId)
+	}
+
+	rules, sport, err := s.standingsRepo.GetRules(ctx, in.CompetitionId)
+	if err != nil {
+		return nil, err
+	}
+
+	if rules == nil {
+		return nil, rpcerror.NotFound("sports.Competition", in.CompetitionId, "competition %d not found", in.CompetitionId)
+	}
+
+	season := in.Season
+	if season == "" {
+		if season, err = s.standingsRepo.LatestSeason(ctx, in.CompetitionId); err != nil {
+			return nil, err
+		}
+	}
+
+	standings, err := s.standingsRepo.List(ctx, in.CompetitionId, season)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, standing := range standings {
+		standing.Points = standing.Won*rules.Win + standing.Drawn*rules.Draw + standing.Lost*rules.Loss
+
+		if standing.PointsAgainst > 0 {
+			standing.Percentage = float64(standing.PointsFor) / float64(standing.PointsAgainst) * 100
+		}
+	}
+
+	sort.SliceStable(standings, func(i, j int) bool {
+		return ranksHigher(standings[i], standings[j], sport)
+	})
+
+	for i, standing := range standings {
+		standing.Position = int64(i + 1)
+	}
+
+	return &sports.GetStandingsResponse{
+		CompetitionId: in.CompetitionId,
+		Season:        season,
+		PointsRules:   rules,
+		Standings:     standings,
+	}, nil
+}
+
+// ranksHigher reports whether team a ranks higher than team b on the ladder.
+// Teams on the same points are separated by percentage in AFL and by the score difference in other sports.
+func ranksHigher(a, b *sports.Standing, sport string) bool {
+	if a.Points != b.Points {
+		return a.Points > b.Points
+	}
+
+	differenceA, differenceB := a.PointsFor-a.PointsAgainst, b.PointsFor-b.PointsAgainst
+
+	if sport == "afl" && a.Percentage != b.Percentage {
+		return a.Percentage > b.Percentage
+	}
+
+	if differenceA != differenceB {
+		return differenceA > differenceB
+	}
+
+	if a.PointsFor != b.PointsFor {
+		return a.PointsFor > b.PointsFor
+	}
+
+	return a.Team < b.Team
+}